@@ -0,0 +1,52 @@
+package gohangul
+
+import "time"
+
+// AgeSystem 나이를 세는 방법
+type AgeSystem int
+
+const (
+	AgeInternational AgeSystem = iota // 만 나이: 생일이 지날 때마다 한 살씩 먹습니다. (법령, 행정의 기본)
+	AgeYear                           // 연 나이: 올해에서 태어난 해를 뺍니다. (병역법, 청소년 보호법)
+	AgeCounting                       // 세는 나이: 태어나면 한 살이고, 해가 바뀔 때마다 한 살씩 먹습니다.
+)
+
+// Age 태어난 날 birth 와 기준 날짜 now 로 나이를 계산합니다. 각 시각의 시간대에서의 날짜를 씁니다.
+// 2월 29일에 태어났다면 윤년이 아닌 해에는 3월 1일에 한 살을 먹습니다.
+// now 가 birth 보다 앞서면 ErrTimeRange 오류를 반환합니다.
+//
+//	Age(birth, now, AgeInternational) // 25
+func Age(birth, now time.Time, system AgeSystem) (int, error) {
+	by, bm, bd := birth.Date()
+	ny, nm, nd := now.Date()
+	if ny < by || ny == by && (nm < bm || nm == bm && nd < bd) {
+		return 0, &TimeError{Func: "Age", Value: birth.Format("2006-01-02"), Err: ErrTimeRange}
+	}
+
+	age := ny - by
+	switch system {
+	case AgeInternational:
+		if nm < bm || nm == bm && nd < bd {
+			age--
+		}
+	case AgeCounting:
+		age++
+	}
+	return age, nil
+}
+
+// AgeToHangul 나이를 고유어 수사와 '살'로 씁니다. 만 나이에는 '만'을 붙이고, 오류는 Age 의 오류를 그대로 반환합니다.
+//
+//	AgeToHangul(birth, now, AgeInternational) // 만 스물다섯 살
+//	AgeToHangul(birth, now, AgeCounting)      // 스물일곱 살
+func AgeToHangul(birth, now time.Time, system AgeSystem) (string, error) {
+	age, err := Age(birth, now, system)
+	if err != nil {
+		return "", err
+	}
+
+	if system == AgeInternational {
+		return "만 " + CountToHangul(age, "살"), nil
+	}
+	return CountToHangul(age, "살"), nil
+}
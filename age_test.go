@@ -0,0 +1,78 @@
+package gohangul
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func BenchmarkAgeToHangul(b *testing.B) {
+	birth := time.Date(2000, 12, 25, 0, 0, 0, 0, time.UTC)
+	now := time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < b.N; i++ {
+		AgeToHangul(birth, now, AgeInternational)
+	}
+}
+
+func TestAge(t *testing.T) {
+	date := func(y int, m time.Month, d int) time.Time {
+		return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	}
+	tests := []struct {
+		birth    time.Time
+		now      time.Time
+		system   AgeSystem
+		expected int
+	}{
+		{date(2000, 12, 25), date(2026, 10, 18), AgeInternational, 25},
+		{date(2000, 12, 25), date(2026, 12, 25), AgeInternational, 26},
+		{date(2000, 12, 25), date(2026, 10, 18), AgeYear, 26},
+		{date(2000, 12, 25), date(2026, 10, 18), AgeCounting, 27},
+		{date(2000, 2, 29), date(2025, 2, 28), AgeInternational, 24},
+		{date(2000, 2, 29), date(2025, 3, 1), AgeInternational, 25},
+		{date(2026, 10, 18), date(2026, 10, 18), AgeInternational, 0},
+		{date(2026, 10, 18), date(2026, 10, 18), AgeYear, 0},
+		{date(2026, 10, 18), date(2026, 10, 18), AgeCounting, 1},
+		{date(2026, 12, 31), date(2027, 1, 1), AgeCounting, 2},
+	}
+
+	for _, test := range tests {
+		result, err := Age(test.birth, test.now, test.system)
+		if err != nil || result != test.expected {
+			t.Errorf("Age(%v, %v, %d) = %d, %v; want %d", test.birth, test.now, test.system, result, err, test.expected)
+		}
+	}
+
+	if _, err := Age(date(2026, 10, 19), date(2026, 10, 18), AgeInternational); !errors.Is(err, ErrTimeRange) {
+		t.Errorf("Age error = %v; want %v", err, ErrTimeRange)
+	}
+}
+
+func TestAgeToHangul(t *testing.T) {
+	birth := time.Date(2000, 12, 25, 0, 0, 0, 0, time.UTC)
+	now := time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		birth    time.Time
+		system   AgeSystem
+		expected string
+	}{
+		{birth, AgeInternational, "만 스물다섯 살"},
+		{birth, AgeYear, "스물여섯 살"},
+		{birth, AgeCounting, "스물일곱 살"},
+		{time.Date(2006, 1, 1, 0, 0, 0, 0, time.UTC), AgeInternational, "만 스무 살"},
+		{time.Date(1926, 1, 1, 0, 0, 0, 0, time.UTC), AgeInternational, "만 백 살"},
+		{time.Date(1925, 1, 1, 0, 0, 0, 0, time.UTC), AgeCounting, "백두 살"},
+	}
+
+	for _, test := range tests {
+		result, err := AgeToHangul(test.birth, now, test.system)
+		if err != nil || result != test.expected {
+			t.Errorf("AgeToHangul(%v, %v, %d) = %q, %v; want %q", test.birth, now, test.system, result, err, test.expected)
+		}
+	}
+
+	_, want := Age(now, birth, AgeCounting)
+	if _, err := AgeToHangul(now, birth, AgeCounting); !errors.Is(err, ErrTimeRange) || err.Error() != want.Error() {
+		t.Errorf("AgeToHangul error = %v; want %v", err, want)
+	}
+}
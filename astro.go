@@ -0,0 +1,300 @@
+package gohangul
+
+import (
+	"math"
+	"time"
+)
+
+// 천문 계산은 Jean Meeus, Astronomical Algorithms (2판)의 식을 따릅니다.
+// 태양의 겉보기 황경은 25장의 VSOP87 주요 항으로 구하며 1900년부터 2100년까지 수 분 이내의 오차로 절기를 계산합니다.
+// 음력 달력은 미리 계산한 표(lunarYearInfo)를 쓰며, 표를 만드는 삭(합삭)의 계산은 lunar_test.go 에 있습니다.
+
+const (
+	j2000           = 2451545.0 // 2000년 1월 1일 12시 (TT)의 율리우스일
+	unixEpochJD     = 2440587.5 // 1970년 1월 1일 0시 (UT)의 율리우스일
+	tropicalYear    = 365.2422
+	secondsPerDay   = 86400
+	degreesToRadian = math.Pi / 180
+)
+
+var (
+	// 한국 표준시: 동경 135도 (UTC+9)
+	koreaStandardTime = time.FixedZone("KST", 9*60*60)
+	// 동경 127.5도 (UTC+8:30)를 표준 자오선으로 쓴 기간의 시간대
+	koreaMeridian1275 = time.FixedZone("KST", 8*60*60+30*60)
+
+	// 동경 127.5도를 표준 자오선으로 쓴 기간 [시작, 끝)
+	meridian1275Periods = [][2]time.Time{
+		{time.Date(1800, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(1912, 1, 1, 0, 0, 0, 0, koreaMeridian1275)},
+		{time.Date(1954, 3, 21, 0, 0, 0, 0, koreaStandardTime), time.Date(1961, 8, 10, 0, 0, 0, 0, koreaMeridian1275)},
+	}
+
+	// VSOP87 에서 지구의 일심 황경 (L0~L5)을 구하는 주요 항 (A, B, C): A cos(B + Cτ)
+	earthLongitudeTerms = [][][3]float64{
+		{
+			{175347046, 0, 0},
+			{3341656, 4.6692568, 6283.0758500},
+			{34894, 4.62610, 12566.15170},
+			{3497, 2.7441, 5753.3849},
+			{3418, 2.8289, 3.5231},
+			{3136, 3.6277, 77713.7715},
+			{2676, 4.4181, 7860.4194},
+			{2343, 6.1352, 3930.2097},
+			{1324, 0.7425, 11506.7698},
+			{1273, 2.0371, 529.6910},
+			{1199, 1.1096, 1577.3435},
+			{990, 5.233, 5884.927},
+			{902, 2.045, 26.298},
+			{857, 3.508, 398.149},
+			{780, 1.179, 5223.694},
+			{753, 2.533, 5507.553},
+			{505, 4.583, 18849.228},
+			{492, 4.205, 775.523},
+			{357, 2.920, 0.067},
+			{317, 5.849, 11790.629},
+			{284, 1.899, 796.298},
+			{271, 0.315, 10977.079},
+			{243, 0.345, 5486.778},
+			{206, 4.806, 2544.314},
+			{205, 1.869, 5573.143},
+			{202, 2.458, 6069.777},
+			{156, 0.833, 213.299},
+			{132, 3.411, 2942.463},
+			{126, 1.083, 20.775},
+			{115, 0.645, 0.980},
+			{103, 0.636, 4694.003},
+			{102, 0.976, 15720.839},
+			{102, 4.267, 7.114},
+			{99, 6.21, 2146.17},
+			{98, 0.68, 155.42},
+			{86, 5.98, 161000.69},
+			{85, 1.30, 6275.96},
+			{85, 3.67, 71430.70},
+			{80, 1.81, 17260.15},
+			{79, 3.04, 12036.46},
+			{75, 1.76, 5088.63},
+			{74, 3.50, 3154.69},
+			{74, 4.68, 801.82},
+			{70, 0.83, 9437.76},
+			{62, 3.98, 8827.39},
+			{61, 1.82, 7084.90},
+			{57, 2.78, 6286.60},
+			{56, 4.39, 14143.50},
+			{56, 3.47, 6279.55},
+			{52, 0.19, 12139.55},
+			{52, 1.33, 1748.02},
+			{51, 0.28, 5856.48},
+			{49, 0.49, 1194.45},
+			{41, 5.37, 8429.24},
+			{41, 2.40, 19651.05},
+			{39, 6.17, 10447.39},
+			{37, 6.04, 10213.29},
+			{37, 2.57, 1059.38},
+			{36, 1.71, 2352.87},
+			{36, 1.78, 6812.77},
+			{33, 0.59, 17789.85},
+			{30, 0.44, 83996.85},
+			{30, 2.74, 1349.87},
+			{25, 3.16, 4690.48},
+		},
+		{
+			{628331966747, 0, 0},
+			{206059, 2.678235, 6283.075850},
+			{4303, 2.6351, 12566.1517},
+			{425, 1.590, 3.523},
+			{119, 5.796, 26.298},
+			{109, 2.966, 1577.344},
+			{93, 2.59, 18849.23},
+			{72, 1.14, 529.69},
+			{68, 1.87, 398.15},
+			{67, 4.41, 5507.55},
+			{59, 2.89, 5223.69},
+			{56, 2.17, 155.42},
+			{45, 0.40, 796.30},
+			{36, 0.47, 775.52},
+			{29, 2.65, 7.11},
+			{21, 5.34, 0.98},
+			{19, 1.85, 5486.78},
+			{19, 4.97, 213.30},
+			{17, 2.99, 6275.96},
+			{16, 0.03, 2544.31},
+			{16, 1.43, 2146.17},
+			{15, 1.21, 10977.08},
+			{12, 2.83, 1748.02},
+			{12, 3.26, 5088.63},
+			{12, 5.27, 1194.45},
+			{12, 2.08, 4694.00},
+			{11, 0.77, 553.57},
+			{10, 1.30, 6286.60},
+			{10, 4.24, 1349.87},
+			{9, 2.70, 242.73},
+			{9, 5.64, 951.72},
+			{8, 5.30, 2352.87},
+			{6, 2.65, 9437.76},
+			{6, 4.67, 4690.48},
+		},
+		{
+			{52919, 0, 0},
+			{8720, 1.0721, 6283.0758},
+			{309, 0.867, 12566.152},
+			{27, 0.05, 3.52},
+			{16, 5.19, 26.30},
+			{16, 3.68, 155.42},
+			{10, 0.76, 18849.23},
+			{9, 2.06, 77713.77},
+			{7, 0.83, 775.52},
+			{5, 4.66, 1577.34},
+			{4, 1.03, 7.11},
+			{4, 3.44, 5573.14},
+			{3, 5.14, 796.30},
+			{3, 6.05, 5507.55},
+			{3, 1.19, 242.73},
+			{3, 6.12, 529.69},
+			{3, 0.31, 398.15},
+			{3, 2.28, 553.57},
+			{2, 4.38, 5223.69},
+			{2, 3.75, 0.98},
+		},
+		{
+			{289, 5.844, 6283.076},
+			{35, 0, 0},
+			{17, 5.49, 12566.15},
+			{3, 5.20, 155.42},
+			{1, 4.72, 3.52},
+			{1, 5.30, 18849.23},
+			{1, 5.97, 242.73},
+		},
+		{
+			{114, 3.142, 0},
+			{8, 4.13, 6283.08},
+			{1, 3.84, 12566.15},
+		},
+		{
+			{1, 3.14, 0},
+		},
+	}
+
+	// VSOP87 에서 지구의 동경 (R0, R1)을 구하는 주요 항
+	earthRadiusTerms = [][][3]float64{
+		{
+			{100013989, 0, 0},
+			{1670700, 3.0984635, 6283.0758500},
+			{13956, 3.05525, 12566.15170},
+			{3084, 5.1985, 77713.7715},
+			{1628, 1.1739, 5753.3849},
+			{1576, 2.8469, 7860.4194},
+		},
+		{
+			{103019, 1.107490, 6283.075850},
+			{1721, 1.0644, 12566.1517},
+		},
+	}
+)
+
+// sunLongitude 율리우스일 (TT)의 태양의 겉보기 황경을 도 단위로 반환합니다. (Meeus 25장, VSOP87)
+func sunLongitude(jde float64) float64 {
+	tau := (jde - j2000) / 365250
+	l := vsop87(earthLongitudeTerms, tau)
+	r := vsop87(earthRadiusTerms, tau)
+
+	// 지구의 일심 황경에서 태양의 지심 황경으로 바꾸고 FK5 로 보정합니다.
+	lon := l/degreesToRadian + 180 - 0.09033/3600
+
+	// 황경의 장동과 광행차
+	t := tau * 10
+	omega := (125.04452 - 1934.136261*t) * degreesToRadian
+	sunMean := (280.4665 + 36000.7698*t) * degreesToRadian
+	moonMean := (218.3165 + 481267.8813*t) * degreesToRadian
+	nutation := -17.20*math.Sin(omega) - 1.32*math.Sin(2*sunMean) - 0.23*math.Sin(2*moonMean) + 0.21*math.Sin(2*omega)
+	aberration := -20.4898 / r
+
+	return normalizeDegrees(lon + (nutation+aberration)/3600)
+}
+
+// vsop87 VSOP87 의 항을 더해 τ (J2000 으로부터의 율리우스 천년)의 값을 계산합니다.
+func vsop87(series [][][3]float64, tau float64) float64 {
+	sum, power := 0.0, 1.0
+	for _, terms := range series {
+		v := 0.0
+		for _, term := range terms {
+			v += term[0] * math.Cos(term[1]+term[2]*tau)
+		}
+		sum += v * power
+		power *= tau
+	}
+	return sum / 1e8
+}
+
+// solarTermJDE year 년에 태양의 황경이 longitude 도가 되는 율리우스일 (TT)을 반환합니다.
+func solarTermJDE(year int, longitude float64) float64 {
+	// 1월 1일의 태양 황경은 약 280도입니다.
+	jde := julianDay(time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC)) +
+		normalizeDegrees(longitude-280)/360*tropicalYear
+
+	for i := 0; i < 20; i++ {
+		diff := normalizeDegrees(longitude-sunLongitude(jde)+180) - 180
+		jde += diff / 360 * tropicalYear
+		if math.Abs(diff) < 1e-7 {
+			break
+		}
+	}
+	return jde
+}
+
+// julianDay 시각의 율리우스일을 반환합니다.
+func julianDay(t time.Time) float64 {
+	return unixEpochJD + float64(t.Unix())/secondsPerDay + float64(t.Nanosecond())/1e9/secondsPerDay
+}
+
+// jdeToTime 율리우스일 (TT)을 UT 시각으로 바꿉니다.
+func jdeToTime(jde float64) time.Time {
+	seconds := (jde - unixEpochJD) * secondsPerDay
+	year := 2000 + (jde-j2000)/tropicalYear
+	seconds -= deltaT(year)
+	return time.Unix(int64(math.Floor(seconds)), 0).UTC()
+}
+
+// deltaT 지구 자전의 불규칙성에 따른 TT 와 UT 의 차이를 초 단위로 반환합니다. (Espenak, Meeus)
+func deltaT(year float64) float64 {
+	switch {
+	case year < 1920:
+		t := year - 1900
+		return -2.79 + 1.494119*t - 0.0598939*t*t + 0.0061966*t*t*t - 0.000197*t*t*t*t
+	case year < 1941:
+		t := year - 1920
+		return 21.20 + 0.84493*t - 0.076100*t*t + 0.0020936*t*t*t
+	case year < 1961:
+		t := year - 1950
+		return 29.07 + 0.407*t - t*t/233 + t*t*t/2547
+	case year < 1986:
+		t := year - 1975
+		return 45.45 + 1.067*t - t*t/260 - t*t*t/718
+	case year < 2005:
+		t := year - 2000
+		return 63.86 + 0.3345*t - 0.060374*t*t + 0.0017275*t*t*t + 0.000651814*t*t*t*t + 0.00002373599*t*t*t*t*t
+	case year < 2050:
+		t := year - 2000
+		return 62.92 + 0.32217*t + 0.005589*t*t
+	}
+	u := (year - 1820) / 100
+	return -20 + 32*u*u - 0.5628*(2150-year)
+}
+
+// koreaTime 한국천문연구원의 역법 계산에 쓰는 표준 자오선의 시간대로 시각을 바꿉니다.
+func koreaTime(t time.Time) time.Time {
+	for _, period := range meridian1275Periods {
+		if !t.Before(period[0]) && t.Before(period[1]) {
+			return t.In(koreaMeridian1275)
+		}
+	}
+	return t.In(koreaStandardTime)
+}
+
+// normalizeDegrees 각도를 0 이상 360 미만으로 맞춥니다.
+func normalizeDegrees(deg float64) float64 {
+	deg = math.Mod(deg, 360)
+	if deg < 0 {
+		deg += 360
+	}
+	return deg
+}
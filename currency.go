@@ -0,0 +1,88 @@
+package gohangul
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// CurrencyStyle 금액을 쓰는 방식
+type CurrencyStyle int
+
+const (
+	CurrencyCheck   CurrencyStyle = iota // 금 일백이십삼만 원정
+	CurrencySymbol                       // ₩1,230,000 (일백이십삼만원)
+	CurrencyCompact                      // 123만 원
+)
+
+// CurrencyOptions 금액을 한글로 변환할 때의 설정
+type CurrencyOptions struct {
+	Style CurrencyStyle // 쓰는 방식
+	Round int64         // 반올림할 단위 (10000, 100000000), 0 이면 반올림하지 않고, 단위보다 작은 금액도 반올림하지 않습니다.
+}
+
+// FormatWon 금액을 원 단위로 씁니다.
+// 수표와 계약서에 쓰는 방식은 고쳐 쓰지 못하도록 '십, 백, 천' 앞의 '일'을 빼지 않습니다.
+//
+//	FormatWon(1230000, CurrencyOptions{})                                     // 금 일백이십삼만 원정
+//	FormatWon(1230000, CurrencyOptions{Style: CurrencySymbol})                // ₩1,230,000 (일백이십삼만원)
+//	FormatWon(1234567, CurrencyOptions{Style: CurrencyCompact, Round: 10000}) // 123만 원
+func FormatWon(amount int64, opts CurrencyOptions) string {
+	amount = roundAmount(amount, opts.Round)
+	digits := strconv.FormatInt(amount, 10)
+
+	switch opts.Style {
+	case CurrencySymbol:
+		hangul := NumberToHangulWith(digits, NumberOptions{}) + "원"
+		symbol := "₩" + insertCommas(strings.TrimPrefix(digits, "-"))
+		if amount < 0 {
+			symbol = "-" + symbol
+		}
+		return symbol + " (" + hangul + ")"
+	case CurrencyCompact:
+		return NumberToHangulWith(digits, NumberOptions{Style: NumberMixed}) + " 원"
+	default:
+		return "금 " + NumberToHangulWith(digits, NumberOptions{}) + " 원정"
+	}
+}
+
+// roundAmount 금액을 unit 단위로 반올림합니다. 0.5는 0에서 멀어지는 쪽으로 올립니다.
+// 절댓값이 unit 보다 작은 금액은 그대로 두고, 올리면 int64 를 넘는 금액은 내립니다.
+func roundAmount(amount, unit int64) int64 {
+	if unit <= 1 || (amount < unit && amount > -unit) {
+		return amount
+	}
+
+	rest := amount % unit
+	amount -= rest
+	if rest < 0 {
+		rest = -rest
+		if rest >= unit-rest && amount >= math.MinInt64+unit {
+			amount -= unit
+		}
+	} else if rest >= unit-rest && amount <= math.MaxInt64-unit {
+		amount += unit
+	}
+	return amount
+}
+
+// insertCommas 숫자에 세 자리마다 쉼표를 넣습니다. (1230000 -> 1,230,000)
+func insertCommas(digits string) string {
+	if len(digits) <= 3 {
+		return digits
+	}
+
+	var sb strings.Builder
+	sb.Grow(len(digits) + len(digits)/3)
+
+	head := len(digits) % 3
+	if head == 0 {
+		head = 3
+	}
+	sb.WriteString(digits[:head])
+	for i := head; i < len(digits); i += 3 {
+		sb.WriteByte(',')
+		sb.WriteString(digits[i : i+3])
+	}
+	return sb.String()
+}
@@ -0,0 +1,46 @@
+package gohangul
+
+import (
+	"math"
+	"testing"
+)
+
+func BenchmarkFormatWon(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		FormatWon(1230000, CurrencyOptions{Style: CurrencySymbol})
+	}
+}
+
+func TestFormatWon(t *testing.T) {
+	tests := []struct {
+		amount   int64
+		opts     CurrencyOptions
+		expected string
+	}{
+		{1230000, CurrencyOptions{}, "금 일백이십삼만 원정"},
+		{1110, CurrencyOptions{}, "금 일천일백일십 원정"},
+		{0, CurrencyOptions{}, "금 영 원정"},
+		{1230000, CurrencyOptions{Style: CurrencySymbol}, "₩1,230,000 (일백이십삼만원)"},
+		{500, CurrencyOptions{Style: CurrencySymbol}, "₩500 (오백원)"},
+		{-1500, CurrencyOptions{Style: CurrencySymbol}, "-₩1,500 (마이너스 일천오백원)"},
+		{1230000, CurrencyOptions{Style: CurrencyCompact}, "123만 원"},
+		{123456789, CurrencyOptions{Style: CurrencyCompact}, "1억 2,345만 6,789 원"},
+		{1234567, CurrencyOptions{Style: CurrencyCompact, Round: 10000}, "123만 원"},
+		{1235000, CurrencyOptions{Style: CurrencyCompact, Round: 10000}, "124만 원"},
+		{123456789, CurrencyOptions{Style: CurrencyCompact, Round: 10000}, "1억 2,346만 원"},
+		{149999999, CurrencyOptions{Style: CurrencyCompact, Round: 100000000}, "1억 원"},
+		{150000000, CurrencyOptions{Style: CurrencyCompact, Round: 100000000}, "2억 원"},
+		{-15000, CurrencyOptions{Style: CurrencyCompact, Round: 10000}, "-2만 원"},
+		{4000, CurrencyOptions{Style: CurrencyCompact, Round: 10000}, "4,000 원"},
+		{-4000, CurrencyOptions{Style: CurrencyCompact, Round: 10000}, "-4,000 원"},
+		{math.MaxInt64, CurrencyOptions{Style: CurrencySymbol, Round: 10000}, "₩9,223,372,036,854,770,000 (구백이십이경삼천삼백칠십이조삼백육십팔억오천사백칠십칠만원)"},
+		{math.MinInt64, CurrencyOptions{Style: CurrencySymbol, Round: 10000}, "-₩9,223,372,036,854,770,000 (마이너스 구백이십이경삼천삼백칠십이조삼백육십팔억오천사백칠십칠만원)"},
+	}
+
+	for _, test := range tests {
+		result := FormatWon(test.amount, test.opts)
+		if result != test.expected {
+			t.Errorf("FormatWon(%d, %+v) = %q; want %q", test.amount, test.opts, result, test.expected)
+		}
+	}
+}
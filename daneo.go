@@ -74,3 +74,91 @@ func (d Daneo) GetChoseong() string {
 	}
 	return sb.String()
 }
+
+// Pronounce 단어를 표준 발음법에 따른 발음으로 변환합니다.
+func (d Daneo) Pronounce() Daneo {
+	return standardPronunciation.apply(d)
+}
+
+// MatchChoseong 초성과 완성된 음절을 섞은 검색어가 단어의 어디에 있는지 찾습니다.
+// 검색어는 먼저 조합하므로 "ㄱㅏ"는 "가"로 찾고, 조합하면서 받침이 된 자음("가ㄴㄷ" -> "간ㄷ")은 다음 음절의 초성으로도 맞춥니다.
+// 검색어의 초성만 있는 글자는 그 초성으로 시작하는 음절과, 완성된 음절은 같은 음절과 맞추며,
+// 입력 중인 마지막 음절은 '가'가 '각', '과'와, '갑'이 '가방'의 '가ㅂ'과 맞는 것처럼 앞부분만 맞추어도 됩니다.
+// 공백은 무시하며, 처음으로 일치한 범위를 음절 단위의 위치 [start, end) 로 반환합니다.
+//
+//	Disassemble("안녕하세요").MatchChoseong(Disassemble("ㄴㅎ")) // 1, 3, true
+func (d Daneo) MatchChoseong(query Daneo) (start, end int, ok bool) {
+	var positions []int
+	for i := range d {
+		if !d[i].isSpace() {
+			positions = append(positions, i)
+		}
+	}
+
+	var q Daneo
+	for _, e := range Disassemble(Assemble(query.String())) {
+		if !e.isSpace() {
+			q = append(q, e)
+		}
+	}
+	if len(q) == 0 {
+		return 0, 0, true
+	}
+
+	for i := range positions {
+		if n, ok := d.matchChoseongAt(positions[i:], q); ok {
+			return positions[i], positions[i+n-1] + 1, true
+		}
+	}
+	return 0, 0, false
+}
+
+// matchChoseongAt 단어의 positions 위치의 음절들이 검색어로 시작하는지 확인하고, 맞춘 음절의 수를 반환합니다.
+func (d Daneo) matchChoseongAt(positions []int, query Daneo) (int, bool) {
+	if len(query) == 0 {
+		return 0, true
+	}
+	if len(positions) == 0 {
+		return 0, false
+	}
+
+	q, target := query[0], d[positions[0]]
+	last := len(query) == 1
+
+	// 받침으로 조합된 자음을 다음 음절의 초성으로 맞춥니다. (간ㄷ -> 가나다, 갑 -> 가방)
+	splitJongseong := func() (int, bool) {
+		if !q.isSyllable() || q.Jongseong.Empty() || !target.Jongseong.Empty() || len(positions) < 2 ||
+			!q.Choseong.Equals(target.Choseong) || !q.Jungseong.Equals(target.Jungseong) ||
+			!q.Jongseong.Equals(d[positions[1]].Choseong) {
+			return 0, false
+		}
+		n, ok := d.matchChoseongAt(positions[2:], query[1:])
+		return n + 2, ok
+	}
+
+	if d.matchEumjeol(q, target, last) {
+		if n, ok := d.matchChoseongAt(positions[1:], query[1:]); ok {
+			return n + 1, true
+		}
+	}
+	return splitJongseong()
+}
+
+// matchEumjeol 검색어의 음절 하나가 단어의 음절과 맞는지 확인합니다. last 이면 앞부분만 맞아도 됩니다.
+func (d Daneo) matchEumjeol(q, target Eumjeol, last bool) bool {
+	switch {
+	case !q.isHangul():
+		return q.Equals(target)
+	case !q.isSyllable():
+		return (q.Choseong.Empty() || q.Choseong.Equals(target.Choseong)) &&
+			(q.Jungseong.Empty() || q.Equals(target))
+	case !q.Choseong.Equals(target.Choseong):
+		return false
+	case !last:
+		return q.Equals(target)
+	case q.Jongseong.Empty():
+		return strings.HasPrefix(target.Jungseong.complexJungseongToChoseong(), q.Jungseong.complexJungseongToChoseong())
+	}
+	return q.Jungseong.Equals(target.Jungseong) &&
+		strings.HasPrefix(target.Jongseong.complexJongseongToChoseong(), q.Jongseong.complexJongseongToChoseong())
+}
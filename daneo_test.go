@@ -76,3 +76,67 @@ func TestDaneo_String(t *testing.T) {
 		t.Errorf("Daneo.String() = %v, want %v", got, want)
 	}
 }
+
+func TestDaneo_Pronounce(t *testing.T) {
+	input := Disassemble("값이")
+	want := "갑씨"
+
+	if got := input.Pronounce().Assemble(); got != want {
+		t.Errorf("Daneo.Pronounce() = %v, want %v", got, want)
+	}
+}
+
+func BenchmarkDaneo_MatchChoseong(b *testing.B) {
+	target := Disassemble("안녕하세요 반갑습니다")
+	query := Disassemble("ㅂㄱㅅ")
+	for i := 0; i < b.N; i++ {
+		target.MatchChoseong(query)
+	}
+}
+
+func TestDaneo_MatchChoseong(t *testing.T) {
+	tests := []struct {
+		target string
+		query  string
+		start  int
+		end    int
+		ok     bool
+	}{
+		{"안녕하세요", "ㅇㄴㅎㅅㅇ", 0, 5, true},
+		{"안녕하세요", "ㄴㅎ", 1, 3, true},
+		{"안녕하세요", "안ㄴ", 0, 2, true},
+		{"안녕하세요", "ㅇ녕", 0, 2, true},
+		{"안녕하세요", "아ㄴ", 0, 1, true},
+		{"안녕하세요", "아ㄹ", 0, 0, false},
+		{"안녕하세요", "ㄱㄴ", 0, 0, false},
+		{"가나다라", "가ㄴㄷ", 0, 3, true},
+		{"가나다라", "ㄴ다", 1, 3, true},
+		{"가나다라", "ㄴ다ㄹ마", 0, 0, false},
+		{"가나다라", "ㄱㅏㄴㄷ", 0, 3, true},
+		{"간다", "가ㄴㄷ", 0, 2, true},
+		{"홍 길동", "ㅎㄱㄷ", 0, 4, true},
+		{"홍 길동", "길 ㄷ", 2, 4, true},
+		{"까마귀", "ㄱㅁ", 0, 0, false},
+		{"까마귀", "ㄲㅁ", 0, 2, true},
+		// 입력 중인 마지막 음절
+		{"각도기", "가", 0, 1, true},
+		{"사과", "사고", 0, 2, true},
+		{"가방", "갑", 0, 2, true},
+		{"닭고기", "달", 0, 1, true},
+		{"닭고기", "달ㄱ", 0, 1, true},
+		{"달고기", "닭", 0, 0, false},
+		{"가방", "갑ㅂ", 0, 0, false},
+		// 한글이 아닌 글자
+		{"iPhone 16 케이스", "16ㅋ", 7, 11, true},
+		{"ㄱㄴㄷ", "ㄴㄷ", 1, 3, true},
+		{"안녕", "", 0, 0, true},
+	}
+
+	for _, test := range tests {
+		start, end, ok := Disassemble(test.target).MatchChoseong(Disassemble(test.query))
+		if start != test.start || end != test.end || ok != test.ok {
+			t.Errorf("Daneo(%q).MatchChoseong(%q) = %d, %d, %t; want %d, %d, %t",
+				test.target, test.query, start, end, ok, test.start, test.end, test.ok)
+		}
+	}
+}
@@ -0,0 +1,741 @@
+package gohangul
+
+import (
+	"errors"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// dangiOffset 단기 연도와 서기 연도의 차이
+const dangiOffset = 2333
+
+// 자주 쓰는 레이아웃
+const (
+	LayoutDate     = "{Y}년 {M}월 {D}일"                       // 2026년 10월 18일
+	LayoutDateTime = "{Y}년 {M}월 {D}일 {EEEE} {A} {h}시 {m}분"  // 2026년 10월 18일 일요일 오후 3시 5분
+	LayoutSpoken   = "{Y}년 {MK} {D}일 {EEEE} {A} {hK} {mKh}" // 2026년 시월 18일 일요일 오후 세 시 오 분
+	LayoutDangi    = "{GD} {DY}년 {M}월 {D}일"                 // 단기 4359년 10월 18일
+	LayoutShort    = "{YY}. {M}. {D}. ({E}) {A} {h}:{mm}"   // 26. 10. 18. (일) 오후 3:05
+)
+
+var (
+	// ErrTimeSyntax 날짜나 시각의 형식이 올바르지 않습니다.
+	ErrTimeSyntax = errors.New("invalid time syntax")
+	// ErrTimeRange 날짜나 시각의 값이 범위를 벗어났습니다.
+	ErrTimeRange = errors.New("time value out of range")
+
+	// 레이아웃의 토큰 -> 쓰는 방법
+	timeFormatTokens = map[string]func(t time.Time) string{
+		"YYYY": func(t time.Time) string { return padNumber(t.Year(), 4) },
+		"YY":   func(t time.Time) string { return padNumber(t.Year()%100, 2) },
+		"Y":    func(t time.Time) string { return strconv.Itoa(t.Year()) },
+		"DY":   func(t time.Time) string { return strconv.Itoa(t.Year() + dangiOffset) },
+		"G":    func(t time.Time) string { return "서기" },
+		"GD":   func(t time.Time) string { return "단기" },
+		"MM":   func(t time.Time) string { return padNumber(int(t.Month()), 2) },
+		"M":    func(t time.Time) string { return strconv.Itoa(int(t.Month())) },
+		"MK":   func(t time.Time) string { return monthHanguls[t.Month()-1] },
+		"DD":   func(t time.Time) string { return padNumber(t.Day(), 2) },
+		"D":    func(t time.Time) string { return strconv.Itoa(t.Day()) },
+		"E":    func(t time.Time) string { return Weekday(t.Weekday()) },
+		"EEEE": func(t time.Time) string { return Weekday(t.Weekday(), true) },
+		"A":    func(t time.Time) string { return meridiem(t.Hour()) },
+		"HH":   func(t time.Time) string { return padNumber(t.Hour(), 2) },
+		"H":    func(t time.Time) string { return strconv.Itoa(t.Hour()) },
+		"hh":   func(t time.Time) string { return padNumber(hour12(t.Hour()), 2) },
+		"h":    func(t time.Time) string { return strconv.Itoa(hour12(t.Hour())) },
+		"hK":   func(t time.Time) string { return CountToHangul(hour12(t.Hour()), "시") },
+		"mm":   func(t time.Time) string { return padNumber(t.Minute(), 2) },
+		"m":    func(t time.Time) string { return strconv.Itoa(t.Minute()) },
+		"mK":   func(t time.Time) string { return CountToHangul(t.Minute(), "분") },
+		"mh":   func(t time.Time) string { return halfOr(t.Minute(), strconv.Itoa(t.Minute())+"분") },
+		"mKh":  func(t time.Time) string { return spokenMinute(t.Minute()) },
+		"ss":   func(t time.Time) string { return padNumber(t.Second(), 2) },
+		"s":    func(t time.Time) string { return strconv.Itoa(t.Second()) },
+	}
+
+	// 레이아웃의 토큰 -> 읽는 표현
+	timeParseTokens = map[string]string{
+		"YYYY": `(\d{4})`,
+		"YY":   `(\d{2})`,
+		"Y":    `(\d{1,4})`,
+		"DY":   `(\d{1,4})`,
+		"G":    `(서기)`,
+		"GD":   `(단기)`,
+		"MM":   `(\d{2})`,
+		"M":    `(\d{1,2})`,
+		"MK":   `(` + strings.Join(monthHanguls[:], "|") + `)`,
+		"DD":   `(\d{2})`,
+		"D":    `(\d{1,2})`,
+		"E":    `([일월화수목금토])`,
+		"EEEE": `([일월화수목금토]요일)`,
+		"A":    `(오전|오후)`,
+		"HH":   `(\d{2})`,
+		"H":    `(\d{1,2})`,
+		"hh":   `(\d{2})`,
+		"h":    `(\d{1,2})`,
+		"hK":   `([가-힣]+ 시)`,
+		"mm":   `(\d{2})`,
+		"m":    `(\d{1,2})`,
+		"mK":   `([가-힣]+ 분)`,
+		"mh":   `(반|\d{1,2}분)`,
+		"mKh":  `(반|[가-힣]+ 분)`,
+		"ss":   `(\d{2})`,
+		"s":    `(\d{1,2})`,
+		"YG":   `([가-힣]{2})`,
+		"YGH":  `(\p{Han}{2})`,
+		"YA":   `([가-힣]+)`,
+		"MG":   `([가-힣]{0,2})`,
+		"MGH":  `(\p{Han}{0,2})`,
+		"DG":   `([가-힣]{2})`,
+		"DGH":  `(\p{Han}{2})`,
+	}
+)
+
+// TimeError 날짜나 시각을 읽지 못했을 때의 오류
+type TimeError struct {
+	Func  string // 오류가 난 함수
+	Value string // 입력
+	Err   error  // 오류의 원인
+}
+
+func (e *TimeError) Error() string {
+	return "gohangul." + e.Func + ": parsing " + strconv.Quote(e.Value) + ": " + e.Err.Error()
+}
+
+func (e *TimeError) Unwrap() error {
+	return e.Err
+}
+
+// FormatTime 시각을 레이아웃에 따라 씁니다.
+// 레이아웃의 {YYYY}, {M}, {EEEE}, {A}, {hK} 등의 토큰을 시각의 값으로 바꾸고, 모르는 토큰은 그대로 둡니다.
+//
+//	{YYYY} {YY} {Y}  연도 (2026, 26, 2026)
+//	{G} {GD} {DY}    서기, 단기, 단기 연도 (4359)
+//	{MM} {M} {MK}    월 (01, 1, 일월), 6월과 10월은 유월, 시월로 읽습니다.
+//	{DD} {D}         일 (08, 8)
+//	{E} {EEEE}       요일 (일, 일요일)
+//	{A}              오전, 오후
+//	{HH} {H}         24시간제 시 (15)
+//	{hh} {h} {hK}    12시간제 시 (03, 3, 세 시)
+//	{mm} {m} {mK}    분 (05, 5, 오 분)
+//	{mh} {mKh}       30분은 반, 그 외에는 분 (반, 5분, 오 분), {mKh} 는 정각이면 앞의 공백과 함께 비웁니다.
+//	{ss} {s}         초 (09, 9)
+//	{YG} {YGH} {YA}  음력 연도의 간지와 띠 (병오, 丙午, 말)
+//	{MG} {MGH}       음력 달의 월건 (정유, 丁酉), 윤달은 앞 달의 월건을 씁니다.
+//	{DG} {DGH}       일진 (임인, 壬寅)
+//
+//	FormatTime(t, LayoutDateTime) // 2026년 10월 18일 일요일 오후 3시 5분
+func FormatTime(t time.Time, layout string) string {
+	return formatLayout(layout, func(token string) (string, bool) {
+		format, ok := timeFormatTokens[token]
+		if !ok {
+			d, err := SolarToLunar(t)
+			return ganjiToken(token, t, d, err == nil)
+		}
+		return format(t), true
+	})
+}
+
+// formatLayout 레이아웃의 {토큰}을 format 이 반환한 값으로 바꾸고, 모르는 토큰은 그대로 둡니다.
+// 빈 값의 토큰이 공백과 레이아웃의 끝, 또는 공백 사이에 있으면 앞의 공백을 지웁니다. (세 시 {mKh} -> 세 시)
+func formatLayout(layout string, format func(token string) (string, bool)) string {
+	buf := make([]byte, 0, len(layout)*2)
+
+	for layout != "" {
+		i := strings.IndexByte(layout, '{')
+		if i < 0 {
+			break
+		}
+		buf = append(buf, layout[:i]...)
+		layout = layout[i:]
+
+		j := strings.IndexByte(layout, '}')
+		if j < 0 {
+			break
+		}
+		value, ok := format(layout[1:j])
+		switch {
+		case !ok:
+			buf = append(buf, layout[:j+1]...)
+		case value == "" && len(buf) > 0 && buf[len(buf)-1] == ' ' &&
+			(j+1 == len(layout) || layout[j+1] == ' '):
+			buf = buf[:len(buf)-1]
+		default:
+			buf = append(buf, value...)
+		}
+		layout = layout[j+1:]
+	}
+
+	buf = append(buf, layout...)
+	return string(buf)
+}
+
+// ParseTime 레이아웃에 따라 쓴 시각을 읽습니다. FormatTime 과 같은 토큰을 씁니다.
+// 레이아웃에 없는 값은 1년 1월 1일 0시 0분 0초로 채웁니다.
+//
+//	ParseTime(LayoutDateTime, "2026년 10월 18일 일요일 오후 3시 5분", time.Local)
+func ParseTime(layout, value string, loc *time.Location) (time.Time, error) {
+	syntaxError := &TimeError{Func: "ParseTime", Value: value, Err: ErrTimeSyntax}
+
+	var pattern strings.Builder
+	var tokens []string
+	pattern.WriteString("^")
+	for layout != "" {
+		i := strings.IndexByte(layout, '{')
+		j := strings.IndexByte(layout, '}')
+		if i < 0 || j < i {
+			break
+		}
+
+		token := layout[i+1 : j]
+		expr, ok := timeParseTokens[token]
+		if !ok {
+			pattern.WriteString(regexp.QuoteMeta(layout[:j+1]))
+			layout = layout[j+1:]
+			continue
+		}
+		// 정각이면 {mKh} 를 앞의 공백과 함께 비워 씁니다.
+		if token == "mKh" && strings.HasSuffix(layout[:i], " ") {
+			pattern.WriteString(regexp.QuoteMeta(layout[:i-1]))
+			pattern.WriteString("(?: " + expr + ")?")
+		} else {
+			pattern.WriteString(regexp.QuoteMeta(layout[:i]))
+			pattern.WriteString(expr)
+		}
+		tokens = append(tokens, token)
+		layout = layout[j+1:]
+	}
+	pattern.WriteString(regexp.QuoteMeta(layout))
+	pattern.WriteString("$")
+
+	match := regexp.MustCompile(pattern.String()).FindStringSubmatch(strings.TrimSpace(value))
+	if match == nil {
+		return time.Time{}, syntaxError
+	}
+
+	var c timeFields
+	c.year, c.month, c.day = 1, 1, 1
+	for i, token := range tokens {
+		if !c.set(token, match[i+1]) {
+			return time.Time{}, syntaxError
+		}
+	}
+
+	t, ok := c.time(loc)
+	if !ok {
+		return time.Time{}, &TimeError{Func: "ParseTime", Value: value, Err: ErrTimeRange}
+	}
+	return t, nil
+}
+
+// timeFields 읽은 날짜와 시각의 값
+type timeFields struct {
+	year, month, day     int
+	hour, minute, second int
+	pm, am               bool // 오후, 오전
+	hour12               bool // 12시간제로 읽은 시
+}
+
+// set 레이아웃의 토큰으로 읽은 값을 채웁니다.
+func (c *timeFields) set(token, value string) bool {
+	var n int
+	var ok bool
+
+	switch token {
+	case "G", "GD", "E", "EEEE", "YG", "YGH", "YA", "MG", "MGH", "DG", "DGH":
+		return true
+	case "MK":
+		for i, v := range monthHanguls {
+			if v == value {
+				c.month = i + 1
+				return true
+			}
+		}
+		return false
+	case "A":
+		c.am, c.pm = value == "오전", value == "오후"
+		return true
+	case "hK":
+		n, ok = readHangulCount(value, "시")
+	case "mK":
+		n, ok = readHangulCount(value, "분")
+	case "mh", "mKh":
+		switch {
+		case value == "":
+			n, ok = 0, true
+		case value == "반":
+			n, ok = 30, true
+		case token == "mh":
+			n, ok = atoi(strings.TrimSuffix(value, "분"))
+		default:
+			n, ok = readHangulCount(value, "분")
+		}
+	default:
+		n, ok = atoi(value)
+	}
+	if !ok {
+		return false
+	}
+
+	switch token {
+	case "YYYY", "Y":
+		c.year = n
+	case "YY":
+		c.year = 2000 + n
+	case "DY":
+		c.year = n - dangiOffset
+	case "MM", "M":
+		c.month = n
+	case "DD", "D":
+		c.day = n
+	case "HH", "H":
+		c.hour = n
+	case "hh", "h", "hK":
+		c.hour, c.hour12 = n, true
+	case "mm", "m", "mK", "mh", "mKh":
+		c.minute = n
+	case "ss", "s":
+		c.second = n
+	}
+	return true
+}
+
+// time 읽은 값으로 시각을 만듭니다. 범위를 벗어난 값이 있으면 false 를 반환합니다.
+func (c *timeFields) time(loc *time.Location) (time.Time, bool) {
+	hour := c.hour
+	if c.hour12 || c.am || c.pm {
+		if hour < 1 || hour > 12 {
+			return time.Time{}, false
+		}
+		if hour == 12 {
+			hour = 0
+		}
+		if c.pm {
+			hour += 12
+		}
+	}
+
+	if c.month < 1 || c.month > 12 || c.day < 1 || hour > 23 || c.minute > 59 || c.second > 59 {
+		return time.Time{}, false
+	}
+
+	t := time.Date(c.year, time.Month(c.month), c.day, hour, c.minute, c.second, 0, loc)
+	if t.Day() != c.day {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+// ParseNaturalTime 사람이 쓴 날짜와 시각을 기준 시각에 맞추어 읽습니다.
+// 올해, 내년, 작년 같은 연도와 오늘, 내일, 모레, 어제 같은 날짜, 오전과 오후, 반, 한글로 쓴 시각을 읽습니다.
+// 연, 월, 일 중 쓰지 않은 값은 기준 시각에서 가져오고, 시, 분, 초는 0으로 채웁니다.
+//
+//	ParseNaturalTime("내년 3월 2일 오전 10시", now) // 내년 3월 2일 10:00
+//	ParseNaturalTime("모레 오후 세 시 반", now)     // 모레 15:30
+func ParseNaturalTime(value string, now time.Time) (time.Time, error) {
+	t, err := parseNaturalTime(value, now)
+	if err != nil {
+		return time.Time{}, &TimeError{Func: "ParseNaturalTime", Value: value, Err: err}
+	}
+	return t, nil
+}
+
+// naturalTimeRule 사람이 쓴 날짜와 시각의 한 부분을 읽는 규칙
+type naturalTimeRule struct {
+	pattern *regexp.Regexp
+	apply   func(s *naturalTimeState, groups []string) bool
+}
+
+// naturalTimeState 사람이 쓴 날짜와 시각을 읽은 상태
+type naturalTimeState struct {
+	now                    time.Time
+	date                   time.Time // 날짜를 정한 날, 0 이면 정하지 않았습니다.
+	year, month, day       int       // 0 이면 정하지 않았습니다.
+	hour, minute, second   int
+	hasHour                bool
+	am, pm, night, daytime bool
+	exact                  time.Time // 방금, 5분 전처럼 정한 시각, 0 이면 정하지 않았습니다.
+	parts                  int       // 읽은 부분의 수
+}
+
+var (
+	// 연도를 나타내는 말 -> 올해와의 차이
+	yearWords = map[string]int{
+		"재작년": -2,
+		"작년":  -1,
+		"지난해": -1,
+		"올해":  0,
+		"금년":  0,
+		"내년":  1,
+		"명년":  1,
+		"후년":  2,
+		"내후년": 2,
+	}
+
+	// 날을 나타내는 말 -> 오늘과의 차이
+	dayWords = map[string]int{
+		"그끄저께": -3,
+		"그저께":  -2,
+		"그제":   -2,
+		"엊그제":  -2,
+		"어제":   -1,
+		"오늘":   0,
+		"금일":   0,
+		"내일":   1,
+		"명일":   1,
+		"모레":   2,
+		"글피":   3,
+	}
+
+	naturalTimeRules = []naturalTimeRule{
+		{
+			regexp.MustCompile(`^(방금|지금|곧)`),
+			func(s *naturalTimeState, g []string) bool {
+				s.exact = s.now
+				return true
+			},
+		},
+		{
+			regexp.MustCompile(`^(\d+|[가-힣]{1,6}?)\s?(초|분|시간|일|주|개월|달|년)\s?(전|후|뒤)`),
+			func(s *naturalTimeState, g []string) bool {
+				n, ok := atoi(g[1])
+				if !ok {
+					n, ok = readHangulCount(g[1], "")
+				}
+				if g[3] == "전" {
+					n = -n
+				}
+				s.exact = addRelative(s.now, n, g[2])
+				return ok
+			},
+		},
+		{
+			regexp.MustCompile(`^(지난|이번|다음)\s?주\s?([일월화수목금토])요일`),
+			func(s *naturalTimeState, g []string) bool {
+				offset := map[string]int{"지난": -1, "이번": 0, "다음": 1}[g[1]]
+				s.date = weekdayOfWeek(s.now, offset, weekdayAbbrs[g[2]])
+				return true
+			},
+		},
+		{
+			regexp.MustCompile(`^(서기|단기)?\s?(\d{1,4})년`),
+			func(s *naturalTimeState, g []string) bool {
+				s.year, _ = strconv.Atoi(g[2])
+				if g[1] == "단기" {
+					s.year -= dangiOffset
+				}
+				return true
+			},
+		},
+		{
+			regexp.MustCompile(`^(` + joinKeys(yearWords) + `)`),
+			func(s *naturalTimeState, g []string) bool {
+				s.year = s.now.Year() + yearWords[g[1]]
+				return true
+			},
+		},
+		{
+			regexp.MustCompile(`^(` + strings.Join(monthHanguls[:], "|") + `)`),
+			func(s *naturalTimeState, g []string) bool {
+				for i, v := range monthHanguls {
+					if v == g[1] {
+						s.month = i + 1
+					}
+				}
+				return true
+			},
+		},
+		{
+			regexp.MustCompile(`^(\d{1,2})월`),
+			func(s *naturalTimeState, g []string) bool {
+				s.month, _ = strconv.Atoi(g[1])
+				return true
+			},
+		},
+		{
+			regexp.MustCompile(`^(이번|다음|지난)\s?달`),
+			func(s *naturalTimeState, g []string) bool {
+				offset := map[string]int{"이번": 0, "다음": 1, "지난": -1}[g[1]]
+				first := time.Date(s.now.Year(), s.now.Month()+time.Month(offset), 1, 0, 0, 0, 0, s.now.Location())
+				s.year, s.month = first.Year(), int(first.Month())
+				return true
+			},
+		},
+		{
+			regexp.MustCompile(`^(\d{1,2})일`),
+			func(s *naturalTimeState, g []string) bool {
+				s.day, _ = strconv.Atoi(g[1])
+				return true
+			},
+		},
+		{
+			regexp.MustCompile(`^(` + joinKeys(dayWords) + `)`),
+			func(s *naturalTimeState, g []string) bool {
+				s.date = s.now.AddDate(0, 0, dayWords[g[1]])
+				return true
+			},
+		},
+		{
+			regexp.MustCompile(`^(오전|새벽|아침)`),
+			func(s *naturalTimeState, g []string) bool {
+				s.am = true
+				return true
+			},
+		},
+		{
+			regexp.MustCompile(`^(오후|저녁)`),
+			func(s *naturalTimeState, g []string) bool {
+				s.pm = true
+				return true
+			},
+		},
+		{
+			regexp.MustCompile(`^밤`),
+			func(s *naturalTimeState, g []string) bool {
+				s.night = true
+				return true
+			},
+		},
+		{
+			regexp.MustCompile(`^낮`),
+			func(s *naturalTimeState, g []string) bool {
+				s.daytime = true
+				return true
+			},
+		},
+		{
+			regexp.MustCompile(`^정오`),
+			func(s *naturalTimeState, g []string) bool {
+				s.hour, s.hasHour = 12, true
+				return true
+			},
+		},
+		{
+			regexp.MustCompile(`^자정`),
+			func(s *naturalTimeState, g []string) bool {
+				s.hour, s.hasHour = 0, true
+				return true
+			},
+		},
+		{
+			regexp.MustCompile(`^(\d{1,2})\s?시`),
+			func(s *naturalTimeState, g []string) bool {
+				s.hour, _ = strconv.Atoi(g[1])
+				s.hasHour = true
+				return true
+			},
+		},
+		{
+			regexp.MustCompile(`^([가-힣]{1,4})\s?시`),
+			func(s *naturalTimeState, g []string) bool {
+				n, ok := readHangulCount(g[1], "")
+				s.hour, s.hasHour = n, true
+				return ok
+			},
+		},
+		{
+			regexp.MustCompile(`^반`),
+			func(s *naturalTimeState, g []string) bool {
+				s.minute = 30
+				return s.hasHour
+			},
+		},
+		{
+			regexp.MustCompile(`^(\d{1,2})\s?분`),
+			func(s *naturalTimeState, g []string) bool {
+				s.minute, _ = strconv.Atoi(g[1])
+				return true
+			},
+		},
+		{
+			regexp.MustCompile(`^([가-힣]{1,4})\s?분`),
+			func(s *naturalTimeState, g []string) bool {
+				n, ok := readHangulCount(g[1], "")
+				s.minute = n
+				return ok
+			},
+		},
+		{
+			regexp.MustCompile(`^(\d{1,2})\s?초`),
+			func(s *naturalTimeState, g []string) bool {
+				s.second, _ = strconv.Atoi(g[1])
+				return true
+			},
+		},
+		{
+			regexp.MustCompile(`^([일월화수목금토]요일)`),
+			func(s *naturalTimeState, g []string) bool {
+				return true
+			},
+		},
+	}
+)
+
+// parseNaturalTime 규칙을 차례로 적용하여 사람이 쓴 날짜와 시각을 읽습니다.
+func parseNaturalTime(value string, now time.Time) (time.Time, error) {
+	s := &naturalTimeState{now: now}
+
+	rest := strings.TrimSpace(value)
+	if rest == "" {
+		return time.Time{}, ErrTimeSyntax
+	}
+	for rest != "" {
+		matched := false
+		for _, rule := range naturalTimeRules {
+			groups := rule.pattern.FindStringSubmatch(rest)
+			if groups == nil {
+				continue
+			}
+			if !rule.apply(s, groups) {
+				return time.Time{}, ErrTimeSyntax
+			}
+			rest = strings.TrimLeft(rest[len(groups[0]):], " ,")
+			s.parts++
+			matched = true
+			break
+		}
+		if !matched {
+			return time.Time{}, ErrTimeSyntax
+		}
+	}
+	return s.time()
+}
+
+// time 읽은 값으로 시각을 만듭니다.
+func (s *naturalTimeState) time() (time.Time, error) {
+	year, month, day := s.now.Date()
+	if !s.date.IsZero() {
+		year, month, day = s.date.Date()
+	}
+	if s.year != 0 {
+		year = s.year
+	}
+	if s.month != 0 {
+		month = time.Month(s.month)
+		if s.day == 0 && s.date.IsZero() {
+			day = 1
+		}
+	}
+	if s.day != 0 {
+		day = s.day
+	}
+
+	if !s.exact.IsZero() {
+		if s.parts > 1 {
+			return time.Time{}, ErrTimeSyntax
+		}
+		return s.exact, nil
+	}
+
+	hour := s.hour
+	if !s.hasHour {
+		// 시를 쓰지 않으면 오전, 오후, 낮, 밤이 시작하는 시각으로 읽습니다.
+		switch {
+		case s.pm || s.daytime:
+			hour = 12
+		case s.night:
+			hour = 18
+		}
+	} else if s.am || s.pm || s.night || s.daytime {
+		if hour < 1 || hour > 12 {
+			return time.Time{}, ErrTimeRange
+		}
+		switch {
+		case s.am && hour == 12:
+			hour = 0
+		case s.pm && hour < 12:
+			hour += 12
+		case s.night && hour >= 6:
+			// 밤 열두 시는 다음 날 0시입니다.
+			hour += 12
+		case s.daytime && hour < 6:
+			hour += 12
+		}
+	}
+
+	if month < 1 || month > 12 || day < 1 || hour > 24 || s.minute > 59 || s.second > 59 {
+		return time.Time{}, ErrTimeRange
+	}
+
+	t := time.Date(year, month, day, 0, 0, 0, 0, s.now.Location())
+	if t.Day() != day {
+		return time.Time{}, ErrTimeRange
+	}
+	return t.Add(time.Duration(hour)*time.Hour + time.Duration(s.minute)*time.Minute + time.Duration(s.second)*time.Second), nil
+}
+
+// readHangulCount 한글로 읽은 수사와 단위 명사를 숫자로 바꿉니다. (세 시 -> 3)
+func readHangulCount(value, counter string) (int, bool) {
+	value = strings.TrimSpace(strings.TrimSuffix(value, counter))
+	n, err := HangulToInt(value)
+	if err != nil || !n.IsInt64() {
+		return 0, false
+	}
+	return int(n.Int64()), true
+}
+
+// meridiem 시에 맞는 오전, 오후를 반환합니다.
+func meridiem(hour int) string {
+	if hour < 12 {
+		return "오전"
+	}
+	return "오후"
+}
+
+// hour12 24시간제의 시를 12시간제로 바꿉니다.
+func hour12(hour int) int {
+	if hour%12 == 0 {
+		return 12
+	}
+	return hour % 12
+}
+
+// halfOr 30분이면 '반'을, 아니면 other 를 반환합니다.
+func halfOr(minute int, other string) string {
+	if minute == 30 {
+		return "반"
+	}
+	return other
+}
+
+// spokenMinute 분을 한글로 읽습니다. 30분은 '반'으로 읽고, 정각이면 빈 문자열을 반환합니다.
+func spokenMinute(minute int) string {
+	if minute == 0 {
+		return ""
+	}
+	return halfOr(minute, CountToHangul(minute, "분"))
+}
+
+// padNumber 숫자를 width 자리에 맞추어 앞을 0으로 채웁니다.
+func padNumber(n, width int) string {
+	s := strconv.Itoa(n)
+	if len(s) < width {
+		s = strings.Repeat("0", width-len(s)) + s
+	}
+	return s
+}
+
+// atoi 숫자를 읽습니다.
+func atoi(s string) (int, bool) {
+	n, err := strconv.Atoi(s)
+	return n, err == nil
+}
+
+// joinKeys 표의 말을 긴 것부터 '|'로 이어 정규 표현식으로 씁니다.
+func joinKeys(m map[string]int) string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if len(keys[i]) != len(keys[j]) {
+			return len(keys[i]) > len(keys[j])
+		}
+		return keys[i] < keys[j]
+	})
+	return strings.Join(keys, "|")
+}
@@ -0,0 +1,145 @@
+package gohangul
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func BenchmarkFormatTime(b *testing.B) {
+	t := time.Date(2026, time.October, 18, 15, 5, 0, 0, time.UTC)
+	for i := 0; i < b.N; i++ {
+		FormatTime(t, LayoutDateTime)
+	}
+}
+
+func TestFormatTime(t *testing.T) {
+	afternoon := time.Date(2026, time.October, 18, 15, 5, 9, 0, time.UTC)
+	morning := time.Date(2026, time.June, 8, 0, 30, 0, 0, time.UTC)
+	tests := []struct {
+		t        time.Time
+		layout   string
+		expected string
+	}{
+		{afternoon, LayoutDate, "2026년 10월 18일"},
+		{afternoon, LayoutDateTime, "2026년 10월 18일 일요일 오후 3시 5분"},
+		{afternoon, LayoutSpoken, "2026년 시월 18일 일요일 오후 세 시 오 분"},
+		{afternoon, LayoutDangi, "단기 4359년 10월 18일"},
+		{afternoon, LayoutShort, "26. 10. 18. (일) 오후 3:05"},
+		{afternoon, "{G} {YYYY}-{MM}-{DD} {HH}:{mm}:{ss}", "서기 2026-10-18 15:05:09"},
+		{afternoon, "{H}시 {m}분 {s}초", "15시 5분 9초"},
+		{morning, "{MK} {D}일 {E}요일", "유월 8일 월요일"},
+		{morning, "{A} {h}시 {mh}", "오전 12시 반"},
+		{morning, "{A} {hK} {mKh}", "오전 열두 시 반"},
+		{afternoon.Add(-5 * time.Minute), LayoutSpoken, "2026년 시월 18일 일요일 오후 세 시"},
+		{afternoon.Add(-5 * time.Minute), "{hK} {mKh} 정각", "세 시 정각"},
+		{afternoon.Add(-5 * time.Minute), "{hK} {mK}", "세 시 영 분"},
+		{morning, "{hh}:{mm} {unknown} {", "12:30 {unknown} {"},
+	}
+
+	for _, test := range tests {
+		result := FormatTime(test.t, test.layout)
+		if result != test.expected {
+			t.Errorf("FormatTime(%v, %q) = %q; want %q", test.t, test.layout, result, test.expected)
+		}
+	}
+}
+
+func TestParseTime(t *testing.T) {
+	tests := []struct {
+		layout   string
+		value    string
+		expected time.Time
+	}{
+		{LayoutDate, "2026년 10월 18일", time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)},
+		{LayoutDateTime, "2026년 10월 18일 일요일 오후 3시 5분", time.Date(2026, 10, 18, 15, 5, 0, 0, time.UTC)},
+		{LayoutSpoken, "2026년 시월 18일 일요일 오후 세 시 반", time.Date(2026, 10, 18, 15, 30, 0, 0, time.UTC)},
+		{LayoutSpoken, "2026년 시월 18일 일요일 오후 세 시", time.Date(2026, 10, 18, 15, 0, 0, 0, time.UTC)},
+		{LayoutDangi, "단기 4359년 10월 18일", time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)},
+		{LayoutShort, "26. 10. 18. (일) 오전 12:05", time.Date(2026, 10, 18, 0, 5, 0, 0, time.UTC)},
+		{"{A} {hK} {mK}", "오후 열두 시 삼십 분", time.Date(1, 1, 1, 12, 30, 0, 0, time.UTC)},
+		{"{YYYY}-{MM}-{DD} {HH}:{mm}:{ss}", "2026-02-28 23:59:59", time.Date(2026, 2, 28, 23, 59, 59, 0, time.UTC)},
+	}
+
+	for _, test := range tests {
+		result, err := ParseTime(test.layout, test.value, time.UTC)
+		if err != nil || !result.Equal(test.expected) {
+			t.Errorf("ParseTime(%q, %q) = %v, %v; want %v", test.layout, test.value, result, err, test.expected)
+		}
+	}
+
+	errTests := []struct {
+		layout string
+		value  string
+		err    error
+	}{
+		{LayoutDate, "2026/10/18", ErrTimeSyntax},
+		{LayoutDate, "2026년 2월 30일", ErrTimeRange},
+		{LayoutDate, "2026년 13월 1일", ErrTimeRange},
+		{"{A} {h}시", "오후 13시", ErrTimeRange},
+		{"{A} {hK}", "오후 사과 시", ErrTimeSyntax},
+	}
+
+	for _, test := range errTests {
+		_, err := ParseTime(test.layout, test.value, time.UTC)
+		if !errors.Is(err, test.err) {
+			t.Errorf("ParseTime(%q, %q) error = %v; want %v", test.layout, test.value, err, test.err)
+		}
+	}
+
+	now := time.Date(2026, 10, 18, 15, 5, 0, 0, time.UTC)
+	for _, layout := range []string{LayoutDate, LayoutDateTime, LayoutSpoken, LayoutDangi, LayoutShort} {
+		want, _ := ParseTime(layout, FormatTime(now, layout), time.UTC)
+		if FormatTime(want, layout) != FormatTime(now, layout) {
+			t.Errorf("ParseTime(%q, FormatTime()) = %v", layout, want)
+		}
+	}
+}
+
+func TestParseNaturalTime(t *testing.T) {
+	now := time.Date(2026, 10, 18, 15, 5, 0, 0, time.UTC)
+	tests := []struct {
+		value    string
+		expected time.Time
+	}{
+		{"내년 3월 2일 오전 10시", time.Date(2027, 3, 2, 10, 0, 0, 0, time.UTC)},
+		{"작년 12월 25일", time.Date(2025, 12, 25, 0, 0, 0, 0, time.UTC)},
+		{"2026년 10월 18일 일요일 오후 3시 5분", time.Date(2026, 10, 18, 15, 5, 0, 0, time.UTC)},
+		{"단기 4360년 1월 1일", time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"내일 오후 세 시 반", time.Date(2026, 10, 19, 15, 30, 0, 0, time.UTC)},
+		{"모레 저녁 7시", time.Date(2026, 10, 20, 19, 0, 0, 0, time.UTC)},
+		{"어제 밤 열두 시", time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)},
+		{"그저께 낮 2시", time.Date(2026, 10, 16, 14, 0, 0, 0, time.UTC)},
+		{"오늘 정오", time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)},
+		{"다음 달 5일", time.Date(2026, 11, 5, 0, 0, 0, 0, time.UTC)},
+		{"시월 9일", time.Date(2026, 10, 9, 0, 0, 0, 0, time.UTC)},
+		{"3월", time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{"10시 30분 15초", time.Date(2026, 10, 18, 10, 30, 15, 0, time.UTC)},
+		{"오전 열 시 삼십 분", time.Date(2026, 10, 18, 10, 30, 0, 0, time.UTC)},
+	}
+
+	for _, test := range tests {
+		result, err := ParseNaturalTime(test.value, now)
+		if err != nil || !result.Equal(test.expected) {
+			t.Errorf("ParseNaturalTime(%q) = %v, %v; want %v", test.value, result, err, test.expected)
+		}
+	}
+
+	errTests := []struct {
+		value string
+		err   error
+	}{
+		{"", ErrTimeSyntax},
+		{"사과", ErrTimeSyntax},
+		{"반", ErrTimeSyntax},
+		{"2월 30일", ErrTimeRange},
+		{"오후 13시", ErrTimeRange},
+	}
+
+	for _, test := range errTests {
+		_, err := ParseNaturalTime(test.value, now)
+		if !errors.Is(err, test.err) {
+			t.Errorf("ParseNaturalTime(%q) error = %v; want %v", test.value, err, test.err)
+		}
+	}
+}
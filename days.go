@@ -0,0 +1,153 @@
+package gohangul
+
+import (
+	"strconv"
+	"strings"
+)
+
+// daysInMonthCount 날수를 달로 셀 때 한 달의 날수
+const daysInMonthCount = 30
+
+var (
+	// 달의 마지막 날을 나타내는 말 -> 날짜
+	dayOfMonthWords = map[string]int{
+		"그믐":  30,
+		"그믐날": 30,
+	}
+
+	// 고유어 날수 -> 날수 (하루, 보름, 스무날)
+	dayCountWords = buildDayCountWords()
+)
+
+// DayOptions 날수를 고유어로 읽을 때의 설정
+type DayOptions struct {
+	// 달포(한 달 남짓), 두 달처럼 어림하여 읽습니다.
+	// 30일은 한 달, 31일부터 59일까지는 달포, 그 이상은 가까운 달 수로 읽습니다.
+	Approximate bool
+}
+
+// DayCount 날수를 고유어로 읽습니다.
+// 30일까지는 하루, 이틀, 보름처럼 읽고, 그보다 길면 한 달 열흘, 두 달처럼 30일을 한 달로 읽습니다.
+// 99달을 넘으면 한자어로 읽고, 1보다 작으면 ErrNumberRange 오류를 반환합니다.
+//
+//	DayCount(15, DayOptions{})                  // 보름
+//	DayCount(75, DayOptions{})                  // 두 달 보름
+//	DayCount(40, DayOptions{Approximate: true}) // 달포
+func DayCount(days int, opts DayOptions) (string, error) {
+	if days < 1 {
+		return "", &NumberError{Func: "DayCount", Num: strconv.Itoa(days), Err: ErrNumberRange}
+	}
+
+	months, rest := days/daysInMonthCount, days%daysInMonthCount
+	if opts.Approximate {
+		switch {
+		case days == daysInMonthCount:
+			return monthCount(1), nil
+		case months == 1:
+			return "달포", nil
+		case months > 1:
+			if rest*2 >= daysInMonthCount {
+				months++
+			}
+			rest = 0
+		}
+	}
+
+	switch {
+	case days <= len(daysHanguls):
+		return daysHanguls[days-1], nil
+	case months > 99:
+		return spokenNumberHangul(days) + " 일", nil
+	case rest == 0:
+		return monthCount(months), nil
+	}
+	return monthCount(months) + " " + daysHanguls[rest-1], nil
+}
+
+// DayOfMonth 한 달의 날짜를 고유어로 읽습니다.
+// 1일부터 10일까지는 초하루, 초열흘처럼 '초'를 붙이고, 달의 마지막 날은 그믐으로 읽습니다.
+// lastDay 는 그 달의 마지막 날짜이며, 범위를 벗어난 날짜는 ErrNumberRange 오류를 반환합니다.
+//
+//	DayOfMonth(1, 30)  // 초하루
+//	DayOfMonth(15, 30) // 보름
+//	DayOfMonth(29, 29) // 그믐
+func DayOfMonth(day, lastDay int) (string, error) {
+	if day < 1 || day > lastDay || lastDay > 31 {
+		return "", &NumberError{Func: "DayOfMonth", Num: strconv.Itoa(day), Err: ErrNumberRange}
+	}
+
+	switch {
+	case day == lastDay && day >= 29:
+		return "그믐", nil
+	case day <= 10:
+		return "초" + daysHanguls[day-1], nil
+	}
+	return daysHanguls[day-1], nil
+}
+
+// ParseDays 고유어로 읽은 날수를 숫자로 바꿉니다.
+// 하루, 보름, 한 달, 두 달 보름 같은 날수와 초하루, 그믐 같은 날짜를 읽습니다.
+// 그믐은 30일로 읽고, 달포처럼 어림한 말은 ErrNumberSyntax 오류를 반환합니다.
+//
+//	ParseDays("보름")      // 15
+//	ParseDays("두 달 보름") // 75
+func ParseDays(str string) (int, error) {
+	syntaxError := &NumberError{Func: "ParseDays", Num: str, Err: ErrNumberSyntax}
+
+	s := strings.Join(strings.Fields(str), "")
+	if day, ok := dayOfMonthWords[s]; ok {
+		return day, nil
+	}
+	if rest, ok := strings.CutPrefix(s, "초"); ok {
+		if day, ok := dayCountWords[rest]; ok && day <= 10 {
+			return day, nil
+		}
+	}
+	if day, ok := dayCountWords[s]; ok {
+		return day, nil
+	}
+
+	before, after, ok := strings.Cut(s, "달")
+	if !ok {
+		return 0, syntaxError
+	}
+	months := 0
+	for i := 1; i <= 99; i++ {
+		if strings.ReplaceAll(monthCount(i), " ", "") == before+"달" {
+			months = i
+			break
+		}
+	}
+	if months == 0 {
+		return 0, syntaxError
+	}
+	if after == "" {
+		return months * daysInMonthCount, nil
+	}
+
+	rest, ok := dayCountWords[after]
+	if !ok || rest >= daysInMonthCount {
+		return 0, syntaxError
+	}
+	return months*daysInMonthCount + rest, nil
+}
+
+// monthCount 달 수를 고유어로 읽습니다. (1 -> 한 달, 3 -> 석 달, 4 -> 넉 달)
+func monthCount(months int) string {
+	switch months {
+	case 3:
+		return "석 달"
+	case 4:
+		return "넉 달"
+	}
+	return NativeNumberToHangul(months, true) + " 달"
+}
+
+// buildDayCountWords 고유어 날수 표를 만듭니다.
+func buildDayCountWords() map[string]int {
+	words := make(map[string]int, len(daysHanguls))
+	for i, v := range daysHanguls {
+		words[v] = i + 1
+	}
+	return words
+}
@@ -0,0 +1,124 @@
+package gohangul
+
+import (
+	"errors"
+	"testing"
+)
+
+func BenchmarkDayCount(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		DayCount(75, DayOptions{})
+	}
+}
+
+func TestDayCount(t *testing.T) {
+	approximate := DayOptions{Approximate: true}
+	tests := []struct {
+		days     int
+		opts     DayOptions
+		expected string
+	}{
+		{1, DayOptions{}, "하루"},
+		{15, DayOptions{}, "보름"},
+		{20, DayOptions{}, "스무날"},
+		{30, DayOptions{}, "서른날"},
+		{31, DayOptions{}, "한 달 하루"},
+		{40, DayOptions{}, "한 달 열흘"},
+		{60, DayOptions{}, "두 달"},
+		{75, DayOptions{}, "두 달 보름"},
+		{90, DayOptions{}, "석 달"},
+		{120, DayOptions{}, "넉 달"},
+		{360, DayOptions{}, "열두 달"},
+		{3000, DayOptions{}, "삼천 일"},
+		{10, approximate, "열흘"},
+		{30, approximate, "한 달"},
+		{40, approximate, "달포"},
+		{59, approximate, "달포"},
+		{70, approximate, "두 달"},
+		{75, approximate, "석 달"},
+	}
+
+	for _, test := range tests {
+		result, err := DayCount(test.days, test.opts)
+		if err != nil || result != test.expected {
+			t.Errorf("DayCount(%d, %+v) = %q, %v; want %q", test.days, test.opts, result, err, test.expected)
+		}
+	}
+
+	for _, days := range []int{0, -3} {
+		if _, err := DayCount(days, DayOptions{}); !errors.Is(err, ErrNumberRange) {
+			t.Errorf("DayCount(%d) error = %v; want %v", days, err, ErrNumberRange)
+		}
+	}
+}
+
+func TestDayOfMonth(t *testing.T) {
+	tests := []struct {
+		day      int
+		lastDay  int
+		expected string
+	}{
+		{1, 30, "초하루"},
+		{10, 30, "초열흘"},
+		{11, 30, "열하루"},
+		{15, 30, "보름"},
+		{20, 30, "스무날"},
+		{29, 30, "스무아흐레"},
+		{29, 29, "그믐"},
+		{30, 30, "그믐"},
+		{30, 31, "서른날"},
+		{31, 31, "그믐"},
+	}
+
+	for _, test := range tests {
+		result, err := DayOfMonth(test.day, test.lastDay)
+		if err != nil || result != test.expected {
+			t.Errorf("DayOfMonth(%d, %d) = %q, %v; want %q", test.day, test.lastDay, result, err, test.expected)
+		}
+	}
+
+	for _, day := range []int{0, 31} {
+		if _, err := DayOfMonth(day, 30); !errors.Is(err, ErrNumberRange) {
+			t.Errorf("DayOfMonth(%d, 30) error = %v; want %v", day, err, ErrNumberRange)
+		}
+	}
+}
+
+func TestParseDays(t *testing.T) {
+	tests := []struct {
+		str      string
+		expected int
+	}{
+		{"하루", 1},
+		{"보름", 15},
+		{"스무아흐레", 29},
+		{"서른날", 30},
+		{"초하루", 1},
+		{"초열흘", 10},
+		{"그믐", 30},
+		{"한 달", 30},
+		{"두 달 보름", 75},
+		{"석 달", 90},
+		{"열두 달", 360},
+	}
+
+	for _, test := range tests {
+		result, err := ParseDays(test.str)
+		if err != nil || result != test.expected {
+			t.Errorf("ParseDays(%q) = %d, %v; want %d", test.str, result, err, test.expected)
+		}
+	}
+
+	for _, str := range []string{"", "달포", "초보름", "세 달", "한 달 서른날", "사과"} {
+		if _, err := ParseDays(str); !errors.Is(err, ErrNumberSyntax) {
+			t.Errorf("ParseDays(%q) error = %v; want %v", str, err, ErrNumberSyntax)
+		}
+	}
+
+	for days := 1; days <= 400; days++ {
+		str, _ := DayCount(days, DayOptions{})
+		if result, err := ParseDays(str); err != nil || result != days {
+			t.Errorf("ParseDays(DayCount(%d)) = %d, %v", days, result, err)
+		}
+	}
+}
@@ -1,5 +1,7 @@
 package gohangul
 
+import "unicode"
+
 // Eumjeol 초성, 중성, 종성으로 이루어진 음절
 type Eumjeol struct {
 	Choseong  Jamo
@@ -47,3 +49,13 @@ func (e Eumjeol) String() string {
 func (e Eumjeol) isHangul() bool {
 	return e.Choseong.IsHangul() || e.Jungseong.IsHangul() || e.Jongseong.IsHangul()
 }
+
+// isSyllable 초성과 중성을 모두 갖춘 완성형 음절인지 확인합니다.
+func (e Eumjeol) isSyllable() bool {
+	return !e.Choseong.Empty() && !e.Jungseong.Empty()
+}
+
+// isSpace 공백 문자인지 확인합니다.
+func (e Eumjeol) isSpace() bool {
+	return e.Jungseong.Empty() && e.Jongseong.Empty() && unicode.IsSpace(rune(e.Choseong))
+}
@@ -0,0 +1,178 @@
+package gohangul
+
+import "strings"
+
+var (
+	// 위 첨자 -> 거듭제곱 지수
+	superscripts = map[rune]rune{
+		'⁰': '0', '¹': '1', '²': '2', '³': '3', '⁴': '4',
+		'⁵': '5', '⁶': '6', '⁷': '7', '⁸': '8', '⁹': '9',
+		'⁻': '-', '⁺': '+',
+	}
+
+	// 곱셈 기호
+	multiplySigns = []string{"×", "*", "x", "·"}
+)
+
+// ExpressionOptions 숫자 표현을 한글로 읽을 때의 설정
+type ExpressionOptions struct {
+	Number  NumberOptions // 숫자를 읽는 방식
+	Percent string        // '%'를 읽는 말, 비어 있으면 '퍼센트'로 읽습니다. (프로)
+}
+
+// ExpressionToHangul 숫자 표현을 읽는 대로 한글로 변환합니다.
+// 분수, 백분율, 비율, 거듭제곱과 지수 표기를 읽고, 읽을 수 없으면 ErrNumberSyntax 오류를 반환합니다.
+//
+//	ExpressionToHangul("2/3", ExpressionOptions{})    // 삼분의 이
+//	ExpressionToHangul("50%", ExpressionOptions{})    // 오십 퍼센트
+//	ExpressionToHangul("3:2", ExpressionOptions{})    // 삼 대 이
+//	ExpressionToHangul("1.5e-3", ExpressionOptions{}) // 일점오 곱하기 십의 마이너스 삼 제곱
+func ExpressionToHangul(expr string, opts ExpressionOptions) (string, error) {
+	result, ok := readExpression(strings.TrimSpace(expr), opts)
+	if !ok {
+		return "", &NumberError{Func: "ExpressionToHangul", Num: expr, Err: ErrNumberSyntax}
+	}
+	return result, nil
+}
+
+// readExpression 숫자 표현의 종류를 찾아 읽습니다.
+func readExpression(expr string, opts ExpressionOptions) (string, bool) {
+	if number, ok := strings.CutSuffix(expr, "%"); ok {
+		hangul, ok := readExprNumber(number, opts)
+		if !ok {
+			return "", false
+		}
+
+		percent := opts.Percent
+		if percent == "" {
+			percent = "퍼센트"
+		}
+		return hangul + " " + percent, true
+	}
+
+	if numerator, denominator, ok := strings.Cut(expr, "/"); ok {
+		return readFraction(numerator, denominator, opts)
+	}
+
+	if strings.Contains(expr, ":") {
+		var parts []string
+		for _, term := range strings.Split(expr, ":") {
+			hangul, ok := readExprNumber(term, opts)
+			if !ok {
+				return "", false
+			}
+			parts = append(parts, hangul)
+		}
+		return strings.Join(parts, " 대 "), true
+	}
+
+	return readPower(replaceSuperscript(expr), opts)
+}
+
+// replaceSuperscript 위 첨자로 쓴 지수를 '^' 표기로 바꿉니다. (10⁻³ -> 10^-3)
+func replaceSuperscript(expr string) string {
+	var sb strings.Builder
+	sb.Grow(len(expr))
+
+	inExponent := false
+	for _, ch := range expr {
+		v, ok := superscripts[ch]
+		if !ok {
+			sb.WriteRune(ch)
+			continue
+		}
+		if !inExponent {
+			sb.WriteByte('^')
+			inExponent = true
+		}
+		sb.WriteRune(v)
+	}
+	return sb.String()
+}
+
+// readFraction 분수를 읽습니다. 부호는 분수 앞에 붙여 읽습니다. (-2/3 -> 마이너스 삼분의 이)
+func readFraction(numerator, denominator string, opts ExpressionOptions) (string, bool) {
+	numerator = strings.TrimSpace(numerator)
+	negative := false
+	if rest, ok := strings.CutPrefix(numerator, "-"); ok {
+		numerator, negative = rest, true
+	}
+
+	num, ok := readExprNumber(numerator, opts)
+	if !ok {
+		return "", false
+	}
+	den, ok := readExprNumber(denominator, opts)
+	if !ok || strings.HasPrefix(strings.TrimSpace(denominator), "-") {
+		return "", false
+	}
+
+	result := den + "분의 " + num
+	if negative {
+		result = "마이너스 " + result
+	}
+	return result, true
+}
+
+// readPower 거듭제곱과 지수 표기를 읽습니다. (2^10, 10^-3, 1.5×10^3, 1.5e-3)
+func readPower(expr string, opts ExpressionOptions) (string, bool) {
+	mantissa, base, exponent := "", "", ""
+
+	if i := strings.IndexAny(expr, "eE"); i > 0 {
+		mantissa, base, exponent = expr[:i], "10", expr[i+1:]
+	} else if b, e, ok := strings.Cut(expr, "^"); ok {
+		base, exponent = b, e
+		for _, sign := range multiplySigns {
+			if m, b, ok := strings.Cut(base, sign); ok {
+				mantissa, base = m, b
+				break
+			}
+		}
+	} else {
+		return readExprNumber(expr, opts)
+	}
+
+	exponent = strings.TrimPrefix(strings.TrimSpace(exponent), "+")
+	exp, ok := readExprNumber(exponent, opts)
+	if !ok || strings.Contains(exponent, ".") {
+		return "", false
+	}
+	power, ok := readExprNumber(base, opts)
+	if !ok {
+		return "", false
+	}
+
+	result := power + "의 " + exp + " 제곱"
+	if mantissa != "" {
+		m, ok := readExprNumber(mantissa, opts)
+		if !ok {
+			return "", false
+		}
+		result = m + " 곱하기 " + result
+	}
+	return result, true
+}
+
+// readExprNumber 부호와 소수점, 쉼표가 있는 숫자 하나를 읽습니다.
+func readExprNumber(number string, opts ExpressionOptions) (string, bool) {
+	number = strings.TrimSpace(number)
+	digits := strings.TrimLeft(number, "+-")
+	if len(number)-len(digits) > 1 || digits == "" {
+		return "", false
+	}
+
+	dot := false
+	for i, ch := range digits {
+		switch {
+		case ch >= '0' && ch <= '9':
+		case ch == '.' && !dot && i > 0 && i < len(digits)-1:
+			dot = true
+		case ch == ',' && !dot && i > 0:
+		default:
+			return "", false
+		}
+	}
+
+	hangul := NumberToHangulWith(number, opts.Number)
+	return hangul, hangul != ""
+}
@@ -0,0 +1,58 @@
+package gohangul
+
+import (
+	"errors"
+	"testing"
+)
+
+func BenchmarkExpressionToHangul(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		ExpressionToHangul("1.5e-3", ExpressionOptions{})
+	}
+}
+
+func TestExpressionToHangul(t *testing.T) {
+	spoken := ExpressionOptions{Number: NumberOptions{OmitOne: true}}
+	tests := []struct {
+		expr     string
+		opts     ExpressionOptions
+		expected string
+	}{
+		{"2/3", ExpressionOptions{}, "삼분의 이"},
+		{"-2/3", ExpressionOptions{}, "마이너스 삼분의 이"},
+		{"1/10", spoken, "십분의 일"},
+		{"50%", ExpressionOptions{}, "오십 퍼센트"},
+		{"50%", ExpressionOptions{Percent: "프로"}, "오십 프로"},
+		{"12.5%", spoken, "십이점오 퍼센트"},
+		{"-3%", ExpressionOptions{}, "마이너스 삼 퍼센트"},
+		{"3:2", ExpressionOptions{}, "삼 대 이"},
+		{"16:9", spoken, "십육 대 구"},
+		{"1:2:3", ExpressionOptions{}, "일 대 이 대 삼"},
+		{"2^10", spoken, "이의 십 제곱"},
+		{"10^-3", spoken, "십의 마이너스 삼 제곱"},
+		{"10⁻³", spoken, "십의 마이너스 삼 제곱"},
+		{"1.5×10^3", spoken, "일점오 곱하기 십의 삼 제곱"},
+		{"1.5e-3", spoken, "일점오 곱하기 십의 마이너스 삼 제곱"},
+		{"6.02E+23", spoken, "육점영이 곱하기 십의 이십삼 제곱"},
+		{"1,000", spoken, "천"},
+		{"-12.5", ExpressionOptions{}, "마이너스 일십이점오"},
+	}
+
+	for _, test := range tests {
+		result, err := ExpressionToHangul(test.expr, test.opts)
+		if err != nil || result != test.expected {
+			t.Errorf("ExpressionToHangul(%q) = %q, %v; want %q", test.expr, result, err, test.expected)
+		}
+	}
+}
+
+func TestExpressionToHangul_Error(t *testing.T) {
+	tests := []string{"", "%", "/3", "2/", "2/-3", "3:", "a:b", "10^", "10^1.5", "e3", "1..2", "--1", "사과"}
+
+	for _, test := range tests {
+		_, err := ExpressionToHangul(test, ExpressionOptions{})
+		if !errors.Is(err, ErrNumberSyntax) {
+			t.Errorf("ExpressionToHangul(%q) error = %v; want %v", test, err, ErrNumberSyntax)
+		}
+	}
+}
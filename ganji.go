@@ -0,0 +1,132 @@
+package gohangul
+
+import "time"
+
+// LayoutGanji 음력 날짜의 간지를 쓰는 레이아웃 (병오년 정유월 임인일)
+const LayoutGanji = "{YG}년 {MG}월 {DG}일"
+
+var (
+	// 천간
+	stemHanguls = [10]string{"갑", "을", "병", "정", "무", "기", "경", "신", "임", "계"}
+	stemHanjas  = [10]string{"甲", "乙", "丙", "丁", "戊", "己", "庚", "辛", "壬", "癸"}
+	// 천간의 색 (갑을: 푸른, 병정: 붉은, 무기: 누런, 경신: 흰, 임계: 검은)
+	stemColors = [5]string{"푸른", "붉은", "누런", "흰", "검은"}
+
+	// 지지
+	branchHanguls = [12]string{"자", "축", "인", "묘", "진", "사", "오", "미", "신", "유", "술", "해"}
+	branchHanjas  = [12]string{"子", "丑", "寅", "卯", "辰", "巳", "午", "未", "申", "酉", "戌", "亥"}
+	// 지지의 동물 (띠)
+	branchAnimals = [12]string{"쥐", "소", "호랑이", "토끼", "용", "뱀", "말", "양", "원숭이", "닭", "개", "돼지"}
+)
+
+// Ganji 육십갑자의 순번 (0: 갑자, 1: 을축, ..., 59: 계해)
+type Ganji int
+
+// YearGanji 연도의 간지(세차)를 반환합니다.
+//
+//	YearGanji(2026) // 병오
+func YearGanji(year int) Ganji {
+	return newGanji(year - 4)
+}
+
+// MonthGanji 음력 연도와 달의 간지(월건)를 반환합니다. 1월은 인월이며, 천간은 그 해의 천간을 따릅니다.
+// 윤달은 따로 월건이 없으므로 앞 달의 월건을 씁니다.
+//
+//	MonthGanji(2026, 8) // 정유
+func MonthGanji(year, month int) Ganji {
+	stem := int(YearGanji(year))%5*2 + 2 + month - 1
+	branch := month + 1
+	return ganjiOf(stem, branch)
+}
+
+// DayGanji 날짜의 간지(일진)를 반환합니다. t 의 시간대에서의 날짜를 씁니다.
+//
+//	DayGanji(time.Date(2026, 9, 25, 0, 0, 0, 0, time.Local)) // 임인
+func DayGanji(t time.Time) Ganji {
+	y, m, d := t.Date()
+	return newGanji(int(julianDay(time.Date(y, m, d, 12, 0, 0, 0, time.UTC))) + 49)
+}
+
+// String 간지를 한글로 씁니다. (병오)
+func (g Ganji) String() string {
+	g = newGanji(int(g))
+	return stemHanguls[g.stem()] + branchHanguls[g.branch()]
+}
+
+// Hanja 간지를 한자로 씁니다. (丙午)
+func (g Ganji) Hanja() string {
+	g = newGanji(int(g))
+	return stemHanjas[g.stem()] + branchHanjas[g.branch()]
+}
+
+// Animal 지지에 해당하는 띠의 동물을 반환합니다. (말)
+func (g Ganji) Animal() string {
+	return branchAnimals[newGanji(int(g)).branch()]
+}
+
+// Color 천간에 해당하는 색을 반환합니다. (붉은)
+func (g Ganji) Color() string {
+	return stemColors[newGanji(int(g)).stem()/2]
+}
+
+// YearName 간지의 해를 색과 띠로 부릅니다.
+//
+//	YearGanji(2026).YearName() // 붉은 말의 해
+func (g Ganji) YearName() string {
+	return g.Color() + " " + g.Animal() + "의 해"
+}
+
+// stem 천간의 순번
+func (g Ganji) stem() int {
+	return int(g) % 10
+}
+
+// branch 지지의 순번
+func (g Ganji) branch() int {
+	return int(g) % 12
+}
+
+// newGanji 순번을 0 이상 60 미만으로 맞춥니다.
+func newGanji(n int) Ganji {
+	n %= 60
+	if n < 0 {
+		n += 60
+	}
+	return Ganji(n)
+}
+
+// ganjiOf 천간과 지지의 순번으로 간지를 만듭니다.
+func ganjiOf(stem, branch int) Ganji {
+	return newGanji(6*stem - 5*branch)
+}
+
+// ganjiToken FormatTime 과 LunarDate.Format 의 간지 토큰을 씁니다.
+// 양력 날짜 t 와 그 음력 날짜 d 를 받으며, 음력으로 바꿀 수 없으면 ok 가 false 입니다.
+func ganjiToken(token string, t time.Time, d LunarDate, ok bool) (string, bool) {
+	var g Ganji
+	switch token {
+	case "YG", "YGH", "YA":
+		if ok {
+			g = YearGanji(d.Year)
+		} else {
+			g = YearGanji(t.Year())
+		}
+	case "MG", "MGH":
+		if !ok {
+			return "", true
+		}
+		g = MonthGanji(d.Year, d.Month)
+	case "DG", "DGH":
+		g = DayGanji(t)
+	default:
+		return "", false
+	}
+
+	switch token {
+	case "YGH", "MGH", "DGH":
+		return g.Hanja(), true
+	case "YA":
+		return g.Animal(), true
+	}
+	return g.String(), true
+}
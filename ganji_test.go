@@ -0,0 +1,112 @@
+package gohangul
+
+import (
+	"testing"
+	"time"
+)
+
+func BenchmarkDayGanji(b *testing.B) {
+	t := time.Date(2026, 9, 25, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < b.N; i++ {
+		DayGanji(t)
+	}
+}
+
+func TestYearGanji(t *testing.T) {
+	tests := []struct {
+		year     int
+		hangul   string
+		hanja    string
+		yearName string
+	}{
+		{1984, "갑자", "甲子", "푸른 쥐의 해"},
+		{2000, "경진", "庚辰", "흰 용의 해"},
+		{2024, "갑진", "甲辰", "푸른 용의 해"},
+		{2025, "을사", "乙巳", "푸른 뱀의 해"},
+		{2026, "병오", "丙午", "붉은 말의 해"},
+		{2027, "정미", "丁未", "붉은 양의 해"},
+		{1, "신유", "辛酉", "흰 닭의 해"},
+	}
+
+	for _, test := range tests {
+		g := YearGanji(test.year)
+		if g.String() != test.hangul || g.Hanja() != test.hanja || g.YearName() != test.yearName {
+			t.Errorf("YearGanji(%d) = %s, %s, %s; want %s, %s, %s",
+				test.year, g, g.Hanja(), g.YearName(), test.hangul, test.hanja, test.yearName)
+		}
+	}
+}
+
+func TestMonthGanji(t *testing.T) {
+	tests := []struct {
+		year     int
+		month    int
+		expected string
+	}{
+		{2024, 1, "병인"},
+		{2025, 1, "무인"},
+		{2026, 1, "경인"},
+		{2026, 8, "정유"},
+		{2026, 12, "신축"},
+		{2027, 1, "임인"},
+		{2028, 1, "갑인"},
+	}
+
+	for _, test := range tests {
+		if result := MonthGanji(test.year, test.month).String(); result != test.expected {
+			t.Errorf("MonthGanji(%d, %d) = %s; want %s", test.year, test.month, result, test.expected)
+		}
+	}
+}
+
+func TestDayGanji(t *testing.T) {
+	tests := []struct {
+		date     time.Time
+		expected string
+	}{
+		{time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC), "갑술"},
+		{time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), "무오"},
+		{time.Date(2000, 1, 7, 0, 0, 0, 0, time.UTC), "갑자"},
+		{time.Date(2026, 9, 25, 0, 0, 0, 0, time.UTC), "임인"},
+		{time.Date(2026, 9, 25, 23, 0, 0, 0, time.FixedZone("KST", 9*60*60)), "임인"},
+	}
+
+	for _, test := range tests {
+		if result := DayGanji(test.date).String(); result != test.expected {
+			t.Errorf("DayGanji(%v) = %s; want %s", test.date, result, test.expected)
+		}
+	}
+}
+
+func TestGanjiFormat(t *testing.T) {
+	chuseok := time.Date(2026, 9, 25, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		layout   string
+		expected string
+	}{
+		{"{Y}년 {YG}년 ({YA}띠)", "2026년 병오년 (말띠)"},
+		{LayoutGanji, "병오년 정유월 임인일"},
+		{"{YGH}年 {MGH}月 {DGH}日", "丙午年 丁酉月 壬寅日"},
+	}
+
+	for _, test := range tests {
+		if result := FormatTime(chuseok, test.layout); result != test.expected {
+			t.Errorf("FormatTime(%v, %q) = %q; want %q", chuseok, test.layout, result, test.expected)
+		}
+	}
+
+	// 음력 설 전에는 지난해의 간지를 씁니다.
+	if result := FormatTime(time.Date(2026, 2, 16, 0, 0, 0, 0, time.UTC), "{YG}"); result != "을사" {
+		t.Errorf("FormatTime(2026-02-16, {YG}) = %q; want %q", result, "을사")
+	}
+
+	leap := LunarDate{Year: 2025, Month: 6, Day: 1, Leap: true}
+	if result := leap.Format("{L}{M}월 {YG}년 {MG}월 {DG}일"); result != "윤6월 을사년 계미월 을미일" {
+		t.Errorf("%+v.Format = %q; want %q", leap, result, "윤6월 을사년 계미월 을미일")
+	}
+
+	parsed, err := ParseTime("{Y}년 {M}월 {D}일 ({DG}일)", "2026년 9월 25일 (임인일)", time.UTC)
+	if err != nil || !parsed.Equal(chuseok) {
+		t.Errorf("ParseTime = %v, %v; want %v", parsed, err, chuseok)
+	}
+}
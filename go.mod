@@ -1 +1,3 @@
 module github.com/yms2772/gohangul
+
+go 1.21
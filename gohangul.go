@@ -1,6 +1,7 @@
 package gohangul
 
 import (
+	"strconv"
 	"strings"
 	"time"
 	"unicode/utf8"
@@ -94,7 +95,7 @@ var (
 		0x11A8: 0x1100, // ㄱ (U+11A8) -> ㄱ (U+1100)
 		0x11A9: 0x1101, // ㄲ (U+11A9) -> ㄱ (U+1101)
 		0x11AB: 0x1102, // ㄴ (U+11AB) -> ㄱ (U+1102)
-		0x11AC: 0x1103, // ㄷ (U+11AC) -> ㄱ (U+1103)
+		0x11AE: 0x1103, // ㄷ (U+11AE) -> ㄱ (U+1103)
 		0x11AF: 0x1105, // ㄹ (U+11AF) -> ㄱ (U+1105)
 		0x11B7: 0x1106, // ㅁ (U+11B7) -> ㄱ (U+1106)
 		0x11B8: 0x1107, // ㅂ (U+11B8) -> ㄱ (U+1107)
@@ -165,7 +166,7 @@ var (
 		0x1100: 0x11A8, // ㄱ
 		0x1101: 0x11A9, // ㄲ
 		0x1102: 0x11AB, // ㄴ
-		0x1103: 0x11AC, // ㄷ
+		0x1103: 0x11AE, // ㄷ
 		0x1105: 0x11AF, // ㄹ
 		0x1106: 0x11B7, // ㅁ
 		0x1107: 0x11B8, // ㅂ
@@ -214,8 +215,8 @@ var (
 	complexJongseongMap = map[string]Jamo{
 		"ㄱㄱ": 0x11A9, // ㄲ
 		"ㄱㅅ": 0x11AA, // ㄳ
-		"ㄴㅈ": 0x11AD, // ㄵ
-		"ㄴㅎ": 0x11AE, // ㄶ
+		"ㄴㅈ": 0x11AC, // ㄵ
+		"ㄴㅎ": 0x11AD, // ㄶ
 		"ㄹㄱ": 0x11B0, // ㄺ
 		"ㄹㅁ": 0x11B1, // ㄻ
 		"ㄹㅂ": 0x11B2, // ㄼ
@@ -231,8 +232,8 @@ var (
 	complexJongseongReversedMap = map[Jamo]string{
 		0x11A9: "ㄱㄱ", // ㄲ
 		0x11AA: "ㄱㅅ", // ㄳ
-		0x11AD: "ㄴㅈ", // ㄵ
-		0x11AE: "ㄴㅎ", // ㄶ
+		0x11AC: "ㄴㅈ", // ㄵ
+		0x11AD: "ㄴㅎ", // ㄶ
 		0x11B0: "ㄹㄱ", // ㄺ
 		0x11B1: "ㄹㅁ", // ㄻ
 		0x11B2: "ㄹㅂ", // ㄼ
@@ -340,8 +341,64 @@ var (
 		"ㅍ": "p",
 		"ㅎ": "h",
 	}
+
+	// 조사 -> 받침에 따른 형태
+	josaTable = map[string]josaForm{
+		"이/가":      {"이", "가", false},
+		"을/를":      {"을", "를", false},
+		"은/는":      {"은", "는", false},
+		"와/과":      {"과", "와", false},
+		"아/야":      {"아", "야", false},
+		"이나/나":     {"이나", "나", false},
+		"이란/란":     {"이란", "란", false},
+		"이랑/랑":     {"이랑", "랑", false},
+		"이라/라":     {"이라", "라", false},
+		"이라도/라도":   {"이라도", "라도", false},
+		"이라서/라서":   {"이라서", "라서", false},
+		"이라고/라고":   {"이라고", "라고", false},
+		"이에요/예요":   {"이에요", "예요", false},
+		"이여/여":     {"이여", "여", false},
+		"이시여/시여":   {"이시여", "시여", false},
+		"이야/야":     {"이야", "야", false},
+		"이며/며":     {"이며", "며", false},
+		"이면/면":     {"이면", "면", false},
+		"이고/고":     {"이고", "고", false},
+		"이지만/지만":   {"이지만", "지만", false},
+		"이든가/든가":   {"이든가", "든가", false},
+		"이든지/든지":   {"이든지", "든지", false},
+		"이나마/나마":   {"이나마", "나마", false},
+		"이야말로/야말로": {"이야말로", "야말로", false},
+		"이다/다":     {"이다", "다", false},
+		"이었/였":     {"이었", "였", false},
+		"였/이었":     {"이었", "였", false},
+		"이었다/였다":   {"이었다", "였다", false},
+		"으로/로":     {"으로", "로", true},
+		"으로서/로서":   {"으로서", "로서", true},
+		"으로써/로써":   {"으로써", "로써", true},
+		"으로부터/로부터": {"으로부터", "로부터", true},
+		"으로는/로는":   {"으로는", "로는", true},
+		"으로도/로도":   {"으로도", "로도", true},
+		"으로만/로만":   {"으로만", "로만", true},
+		"으로의/로의":   {"으로의", "로의", true},
+	}
 )
 
+// josaForm 받침 유무에 따라 달라지는 조사의 형태
+type josaForm struct {
+	batchim   string // 받침이 있을 때
+	noBatchim string // 받침이 없을 때
+	rieul     bool   // 'ㄹ' 받침 뒤에서도 받침이 없을 때의 형태를 사용합니다.
+}
+
+// pick 단어 뒤에 올 조사의 형태를 반환합니다.
+func (f josaForm) pick(word string) string {
+	jong := lastJongseong(word)
+	if jong == 0 || (f.rieul && jong == 'ㄹ') {
+		return f.noBatchim
+	}
+	return f.batchim
+}
+
 var (
 	digitsHangul = [...]string{
 		"", "십", "백", "천",
@@ -364,6 +421,63 @@ var (
 		"팔",
 		"구",
 	}
+	nativeNumberHanguls = [...]string{
+		"",
+		"하나",
+		"둘",
+		"셋",
+		"넷",
+		"다섯",
+		"여섯",
+		"일곱",
+		"여덟",
+		"아홉",
+	}
+	nativeTensHanguls = [...]string{
+		"",
+		"열",
+		"스물",
+		"서른",
+		"마흔",
+		"쉰",
+		"예순",
+		"일흔",
+		"여든",
+		"아흔",
+	}
+	// 단위 명사 앞에서 줄어드는 고유어 수사
+	nativeShortHanguls = map[string]string{
+		"하나": "한",
+		"둘":  "두",
+		"셋":  "세",
+		"넷":  "네",
+		"스물": "스무",
+	}
+	// 고유어 수사와 어울리는 단위 명사
+	// 목록에 없는 단위 명사는 한자어 수사와 씁니다. (분, 원, 년, 층 등)
+	nativeCounters = map[string]bool{
+		"개":  true,
+		"명":  true,
+		"살":  true,
+		"시":  true,
+		"마리": true,
+		"사람": true,
+		"번":  true,
+		"권":  true,
+		"장":  true,
+		"잔":  true,
+		"병":  true,
+		"벌":  true,
+		"채":  true,
+		"대":  true,
+		"그루": true,
+		"송이": true,
+		"켤레": true,
+		"가지": true,
+		"군데": true,
+		"시간": true,
+		"달":  true,
+	}
 	daysHanguls = [...]string{
 		"하루",
 		"이틀",
@@ -405,6 +519,21 @@ var (
 		"금",
 		"토",
 	}
+	// 달의 읽기 (6월 -> 유월, 10월 -> 시월)
+	monthHanguls = [...]string{
+		"일월",
+		"이월",
+		"삼월",
+		"사월",
+		"오월",
+		"유월",
+		"칠월",
+		"팔월",
+		"구월",
+		"시월",
+		"십일월",
+		"십이월",
+	}
 )
 
 // GetChoseong 문자열을 받아서 초성 단위로 분리하여 반환합니다.
@@ -412,48 +541,136 @@ func GetChoseong(word string) string {
 	return Disassemble(word).GetChoseong()
 }
 
-// NumberToHangul 숫자를 한글로 변환합니다.
-func NumberToHangul(number string) string {
-	var sb strings.Builder
-	sb.Grow(len(number))
+// MatchChoseong 초성과 음절을 섞은 검색어("ㅇㄴ", "안ㄴ", "가ㄴㄷ")가 문자열의 어디에 있는지 찾습니다.
+// 일치한 범위를 글자 단위의 위치 [start, end) 로 반환합니다. (Daneo.MatchChoseong 참고)
+//
+//	MatchChoseong("안녕하세요", "ㅇㄴㅎ") // 0, 3, true
+func MatchChoseong(target, query string) (start, end int, ok bool) {
+	return Disassemble(target).MatchChoseong(Disassemble(query))
+}
+
+// FilterChoseong 초성 검색어와 맞는 문자열만 순서대로 골라 반환합니다.
+//
+//	FilterChoseong([]string{"안녕하세요", "안내", "감사"}, "안ㄴ") // [안녕하세요 안내]
+func FilterChoseong(targets []string, query string) []string {
+	q := Disassemble(query)
 
-	for _, ch := range strings.TrimSpace(number) {
-		if (ch >= '0' && ch <= '9') || ch == '.' {
-			sb.WriteRune(ch)
+	var result []string
+	for _, target := range targets {
+		if _, _, ok := Disassemble(target).MatchChoseong(q); ok {
+			result = append(result, target)
 		}
 	}
+	return result
+}
 
-	fields := strings.Split(sb.String(), ".")
-
-	sb.Reset()
-	sb.Grow(len(fields[0]))
+// NumberToHangul 숫자를 한글로 변환합니다.
+// 음수는 '마이너스'를 붙여 읽고, 무량대수를 넘는 숫자는 빈 문자열을 반환합니다.
+func NumberToHangul(number string) string {
+	return NumberToHangulWith(number, NumberOptions{})
+}
 
-	for i, ch := range fields[0] {
-		digitNumber := (len(fields[0]) - i - 1) % len(digitsHangul)
-		digitNumber2 := (len(fields[0]) - i - 1) / len(digitsHangul)
-		if digitNumber2 >= len(digitsHangul2) {
-			return ""
-		}
+// NativeNumberToHangul 1부터 99까지의 숫자를 고유어 수사로 변환합니다.
+// short 가 true 이면 단위 명사 앞에서 쓰는 형태로 변환합니다. (한, 두, 세, 네, 스무)
+// 범위를 벗어난 숫자는 빈 문자열을 반환합니다.
+func NativeNumberToHangul(number int, short ...bool) string {
+	if number < 1 || number > 99 {
+		return ""
+	}
 
-		if ch != '0' {
-			sb.WriteString(numberHanguls[ch-'0'])
-			sb.WriteString(digitsHangul[digitNumber])
-		}
-		if digitNumber == 0 {
-			sb.WriteString(digitsHangul2[digitNumber2])
+	tens := nativeTensHanguls[number/10]
+	ones := nativeNumberHanguls[number%10]
+	if len(short) > 0 && short[0] {
+		if ones == "" {
+			if v, ok := nativeShortHanguls[tens]; ok {
+				tens = v
+			}
+		} else if v, ok := nativeShortHanguls[ones]; ok {
+			ones = v
 		}
 	}
+	return tens + ones
+}
 
-	if len(fields) > 1 {
-		sb.WriteString("점")
+// CountToHangul 숫자와 단위 명사를 읽는 대로 변환합니다.
+// 개, 명, 살, 시, 마리 등은 고유어 수사와, 분, 원, 년, 층 등은 한자어 수사와 씁니다.
+// 고유어 수사는 99까지만 쓰고, 100 이상은 백의 자리부터 한자어로 읽습니다.
+//
+//	CountToHangul(20, "살") // 스무 살
+//	CountToHangul(3, "층")  // 삼 층
+func CountToHangul(number int, counter string) string {
+	if number < 0 {
+		return ""
+	}
+	if !nativeCounters[counter] || number == 0 {
+		return spokenNumberHangul(number) + " " + counter
+	}
 
-		for _, ch := range fields[1] {
-			sb.WriteString(numberHanguls[ch-'0'])
-		}
+	var sb strings.Builder
+	if number >= 100 {
+		sb.WriteString(spokenNumberHangul(number - number%100))
 	}
+	sb.WriteString(NativeNumberToHangul(number%100, true))
+	sb.WriteString(" ")
+	sb.WriteString(counter)
 	return sb.String()
 }
 
+// OrdinalStyle 서수를 쓰는 방식
+type OrdinalStyle int
+
+const (
+	OrdinalNative       OrdinalStyle = iota // 첫째, 둘째, 열두째, 스무째
+	OrdinalCounter                          // 첫 번째, 두 번째, 세 번째
+	OrdinalSinoCounter                      // 일 번째, 이 번째
+	OrdinalPrefix                           // 제1, 제2
+	OrdinalPrefixHangul                     // 제일, 제이
+)
+
+// Ordinal 1 이상의 숫자를 서수로 변환합니다.
+// OrdinalNative 는 99까지만 쓰고, 범위를 벗어난 숫자는 빈 문자열을 반환합니다.
+//
+//	Ordinal(3, OrdinalPrefix) + "조"     // 제3조
+//	Ordinal(3, OrdinalCounter) + " 항목" // 세 번째 항목
+func Ordinal(number int, style OrdinalStyle) string {
+	if number < 1 {
+		return ""
+	}
+
+	switch style {
+	case OrdinalNative:
+		switch number {
+		case 1:
+			return "첫째"
+		case 2:
+			return "둘째"
+		}
+		if number > 99 {
+			return ""
+		}
+		// 열한째, 열두째, 스무째 처럼 하나, 둘, 스물은 줄여 씁니다.
+		short := number%10 == 1 || number%10 == 2 || number == 20
+		return NativeNumberToHangul(number, short) + "째"
+	case OrdinalCounter:
+		if number == 1 {
+			return "첫 번째"
+		}
+		return CountToHangul(number, "번") + "째"
+	case OrdinalSinoCounter:
+		return spokenNumberHangul(number) + " 번째"
+	case OrdinalPrefix:
+		return "제" + strconv.Itoa(number)
+	case OrdinalPrefixHangul:
+		return "제" + spokenNumberHangul(number)
+	}
+	return ""
+}
+
+// spokenNumberHangul 숫자를 말할 때처럼 한자어 수사로 변환합니다. (백, 천, 만)
+func spokenNumberHangul(number int) string {
+	return NumberToHangulWith(strconv.Itoa(number), NumberOptions{OmitOne: true})
+}
+
 // HasBatchim 받침이 있는지 판단합니다.
 func HasBatchim(str string, onlyDouble ...bool) bool {
 	lastRune, _ := utf8.DecodeLastRuneInString(str)
@@ -477,105 +694,26 @@ func HasBatchim(str string, onlyDouble ...bool) bool {
 }
 
 // JosaPick 단어와 조사를 받아 적절한 조사를 반환합니다.
-// 지원하는 조사: 이/가, 을/를, 은/는, 으로/로, 와/과,
-// 이나/나, 이란/란, 아/야, 이랑/랑, 이에요/예요,
-// 으로서/로서, 으로써/로써, 으로부터/로부터, 이라/라
+// 숫자, 로마자, 기호로 끝나는 단어는 읽는 소리를 기준으로 판단하며,
+// 끝의 닫는 괄호와 따옴표, 문장 부호(. , ! ?)는 건너뜁니다.
+// '으로/로' 계열의 조사는 'ㄹ' 받침 뒤에서 '로' 형태를 사용합니다.
+// 지원하지 않는 조사는 그대로 반환합니다.
+// 지원하는 조사: 이/가, 을/를, 은/는, 와/과, 아/야, 이나/나, 이란/란, 이랑/랑,
+// 이라/라, 이라도/라도, 이라서/라서, 이라고/라고, 이에요/예요, 이여/여, 이시여/시여,
+// 이야/야, 이며/며, 이면/면, 이고/고, 이지만/지만, 이든가/든가, 이든지/든지,
+// 이나마/나마, 이야말로/야말로, 이다/다, 이었/였, 였/이었, 이었다/였다,
+// 으로/로, 으로서/로서, 으로써/로써, 으로부터/로부터, 으로는/로는, 으로도/로도,
+// 으로만/로만, 으로의/로의
 func JosaPick(word, josaType string) string {
-	hasBatchim := HasBatchim(word)
-
-	switch josaType {
-	case "이/가":
-		if hasBatchim {
-			return "이"
-		} else {
-			return "가"
-		}
-	case "을/를":
-		if hasBatchim {
-			return "을"
-		} else {
-			return "를"
-		}
-	case "은/는":
-		if hasBatchim {
-			return "은"
-		} else {
-			return "는"
-		}
-	case "으로/로":
-		if hasBatchim {
-			return "으로"
-		} else {
-			return "로"
-		}
-	case "와/과":
-		if hasBatchim {
-			return "과"
-		} else {
-			return "와"
-		}
-	case "이나/나":
-		if hasBatchim {
-			return "이나"
-		} else {
-			return "나"
-		}
-	case "이란/란":
-		if hasBatchim {
-			return "이란"
-		} else {
-			return "란"
-		}
-	case "아/야":
-		if hasBatchim {
-			return "아"
-		} else {
-			return "야"
-		}
-	case "이랑/랑":
-		if hasBatchim {
-			return "이랑"
-		} else {
-			return "랑"
-		}
-	case "이에요/예요":
-		if hasBatchim {
-			return "이에요"
-		} else {
-			return "예요"
-		}
-	case "으로서/로서":
-		if hasBatchim {
-			return "으로서"
-		} else {
-			return "로서"
-		}
-	case "으로써/로써":
-		if hasBatchim {
-			return "으로써"
-		} else {
-			return "로써"
-		}
-	case "으로부터/로부터":
-		if hasBatchim {
-			return "으로부터"
-		} else {
-			return "로부터"
-		}
-	case "이라/라":
-		if hasBatchim {
-			return "이라"
-		} else {
-			return "라"
-		}
+	form, ok := josaTable[josaType]
+	if !ok {
+		return josaType
 	}
-	return josaType
+	return form.pick(word)
 }
 
 // Josa 단어와 조사를 받아 적절한 조사를 붙여 반환합니다.
-// 지원하는 조사: 이/가, 을/를, 은/는, 으로/로, 와/과,
-// 이나/나, 이란/란, 아/야, 이랑/랑, 이에요/예요,
-// 으로서/로서, 으로써/로써, 으로부터/로부터, 이라/라
+// 지원하는 조사는 JosaPick 을 참고하세요.
 func Josa(word, josaType string) string {
 	return word + JosaPick(word, josaType)
 }
@@ -701,8 +839,10 @@ func CombineVowels(vowel1, vowel2 string) string {
 }
 
 // Days 일자를 한글로 변환합니다.
+// 1보다 작은 일자는 빈 문자열을 반환합니다. 자세한 설정과 오류는 DayCount 를 사용합니다.
 func Days(day int) string {
-	return daysHanguls[day-1]
+	result, _ := DayCount(day, DayOptions{})
+	return result
 }
 
 // Weekday 요일을 한글로 변환합니다.
@@ -743,22 +883,9 @@ func Disassemble(str string) Daneo {
 	return result
 }
 
-// Romanize 로마자로 변환합니다.
-func Romanize(str string) string {
-	eumjeolList := Disassemble(str)
-	var sb strings.Builder
-	sb.Grow(len(eumjeolList) * 3)
-
-	for _, e := range eumjeolList {
-		if !e.Choseong.Empty() {
-			sb.WriteString(choseongRomaja[e.Choseong.toLetter().String()])
-		}
-		if !e.Jungseong.Empty() {
-			sb.WriteString(jungseongRomaja[e.Jungseong.toLetter().String()])
-		}
-		if !e.Jongseong.Empty() {
-			sb.WriteString(jongseongRomaja[e.Jongseong.toLetter().String()])
-		}
-	}
-	return sb.String()
+// Romanize 표준 발음에 따라 로마자로 변환합니다.
+// literal 이 true 이면 발음의 변화 없이 음절의 자모를 그대로 변환합니다.
+// 인명, 붙임표, 대문자 등의 표기 방식은 RomanizeWith 를 사용합니다.
+func Romanize(str string, literal ...bool) string {
+	return RomanizeWith(str, RomanizeOptions{Literal: len(literal) > 0 && literal[0]})
 }
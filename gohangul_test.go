@@ -1,6 +1,7 @@
 package gohangul
 
 import (
+	"strings"
 	"testing"
 	"time"
 )
@@ -142,18 +143,81 @@ func TestJosaPick(t *testing.T) {
 		{"귤", "이/가", "이"},
 		{"귤", "을/를", "을"},
 		{"귤", "은/는", "은"},
-		{"귤", "으로/로", "으로"},
+		{"귤", "으로/로", "로"},
 		{"귤", "와/과", "과"},
 		{"귤", "이나/나", "이나"},
 		{"귤", "이란/란", "이란"},
 		{"귤", "아/야", "아"},
 		{"귤", "이랑/랑", "이랑"},
 		{"귤", "이에요/예요", "이에요"},
-		{"귤", "으로서/로서", "으로서"},
-		{"귤", "으로써/로써", "으로써"},
-		{"귤", "으로부터/로부터", "으로부터"},
+		{"귤", "으로서/로서", "로서"},
+		{"귤", "으로써/로써", "로써"},
+		{"귤", "으로부터/로부터", "로부터"},
 		{"귤", "이라/라", "이라"},
 		{"귤", "?/?", "?/?"},
+
+		// "ㄹ" 이외의 받침
+		{"밥", "으로/로", "으로"},
+		{"밥", "으로서/로서", "으로서"},
+		{"밥", "으로써/로써", "으로써"},
+		{"밥", "으로부터/로부터", "으로부터"},
+		{"서울", "으로/로", "로"},
+		{"부산", "으로/로", "으로"},
+
+		// 추가 조사
+		{"친구", "이여/여", "여"},
+		{"동지", "이시여/시여", "시여"},
+		{"신", "이시여/시여", "이시여"},
+		{"이번", "이야/야", "이야"},
+		{"사과", "이며/며", "며"},
+		{"귤", "이며/며", "이며"},
+		{"밥", "이든가/든가", "이든가"},
+		{"사과", "이든지/든지", "든지"},
+		{"밥", "이나마/나마", "이나마"},
+		{"너", "이야말로/야말로", "야말로"},
+		{"학생", "이다/다", "이다"},
+		{"사과", "이다/다", "다"},
+		{"사과", "였/이었", "였"},
+		{"학생", "였/이었", "이었"},
+
+		// 숫자, 로마자, 기호
+		{"3", "이/가", "이"},
+		{"2", "을/를", "를"},
+		{"10", "은/는", "은"},
+		{"1,000", "이/가", "이"},
+		{"0", "으로/로", "으로"},
+		{"1.5", "와/과", "와"},
+		{"7", "으로/로", "로"},
+		{"MP3", "을/를", "을"},
+		{"50%", "이/가", "가"},
+		{"Apple", "을/를", "을"},
+		{"Apple", "으로/로", "로"},
+		{"Google", "이/가", "이"},
+		{"iPhone", "을/를", "을"},
+		{"Facebook", "이/가", "이"},
+		{"chat", "을/를", "을"},
+		{"app", "이/가", "이"},
+		{"computer", "이/가", "가"},
+		{"meet", "을/를", "를"},
+		{"system", "이/가", "이"},
+		{"string", "을/를", "을"},
+		{"KBS", "이/가", "가"},
+		{"HTML", "으로/로", "로"},
+		{"HTML", "을/를", "을"},
+		{"CNN", "이/가", "이"},
+
+		// 괄호, 따옴표
+		{"'사과'", "을/를", "를"},
+		{"「귤」", "이/가", "이"},
+		{"사과(沙果)", "을/를", "를"},
+		{"(주)삼성", "이/가", "이"},
+
+		// 끝의 문장 부호
+		{"3.", "이/가", "이"},
+		{"사과!", "을/를", "를"},
+		{"귤?", "이/가", "이"},
+		{"Apple...", "이/가", "이"},
+		{"'3'.", "은/는", "은"},
 	}
 
 	for _, test := range tests {
@@ -174,6 +238,8 @@ func TestJosa(t *testing.T) {
 		{"귤", "이/가", "귤이"},
 		{"사과", "을/를", "사과를"},
 		{"귤", "을/를", "귤을"},
+		{"3", "이/가", "3이"},
+		{"Apple", "을/를", "Apple을"},
 	}
 
 	for _, test := range tests {
@@ -255,8 +321,8 @@ func TestCombineVowels(t *testing.T) {
 }
 
 func TestDays(t *testing.T) {
-	input := []int{14, 2, 29}
-	want := []string{"열나흘", "이틀", "스무아흐레"}
+	input := []int{14, 2, 29, 30, 0, -1, 60}
+	want := []string{"열나흘", "이틀", "스무아흐레", "서른날", "", "", "두 달"}
 
 	for i, v := range input {
 		output := Days(v)
@@ -278,6 +344,52 @@ func TestGetChoseong(t *testing.T) {
 	}
 }
 
+func TestMatchChoseong(t *testing.T) {
+	tests := []struct {
+		target string
+		query  string
+		want   [3]int
+	}{
+		{"안녕하세요", "ㅇㄴㅎ", [3]int{0, 3, 1}},
+		{"안녕하세요", "ㅎㅅ", [3]int{2, 4, 1}},
+		{"안녕하세요", "안ㄴ", [3]int{0, 2, 1}},
+		{"안녕하세요", "ㄱㄴ", [3]int{0, 0, 0}},
+		{"가나다", "ㄱㅏ", [3]int{0, 1, 1}},
+		{"가나다", "가ㄴㄷ", [3]int{0, 3, 1}},
+		{"솨과", "ㅅㅘ", [3]int{0, 1, 1}},
+		// "ㅅㅘ"는 "솨"로 조합되므로 "사과"와는 맞지 않습니다.
+		{"사과", "ㅅㅘ", [3]int{0, 0, 0}},
+		{"사과", "ㅅㄱㅘ", [3]int{0, 2, 1}},
+	}
+
+	for _, test := range tests {
+		start, end, ok := MatchChoseong(test.target, test.query)
+		if start != test.want[0] || end != test.want[1] || ok != (test.want[2] == 1) {
+			t.Errorf("MatchChoseong(%q, %q) = %d, %d, %t; want %v", test.target, test.query, start, end, ok, test.want)
+		}
+	}
+}
+
+func TestFilterChoseong(t *testing.T) {
+	titles := []string{"안녕하세요", "안내", "감사합니다", "가나다"}
+	tests := []struct {
+		query string
+		want  []string
+	}{
+		{"안ㄴ", []string{"안녕하세요", "안내"}},
+		{"ㄱㅅ", []string{"감사합니다"}},
+		{"가ㄴㄷ", []string{"가나다"}},
+		{"ㅋ", nil},
+	}
+
+	for _, test := range tests {
+		output := FilterChoseong(titles, test.query)
+		if strings.Join(output, ",") != strings.Join(test.want, ",") {
+			t.Errorf("FilterChoseong(%q) = %q; want %q", test.query, output, test.want)
+		}
+	}
+}
+
 func TestHasBatchim(t *testing.T) {
 	input := []string{".", "감", "갃", "강", "가"}
 	want := []bool{false, true, true, true, false}
@@ -311,8 +423,8 @@ func TestHasBatchim(t *testing.T) {
 }
 
 func TestNumberToHangul(t *testing.T) {
-	input := []string{"", "12", "0123456", "7890", "1000000", "1000000.1023", "102001030", "100000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"}
-	want := []string{"", "일십이", "일십이만삼천사백오십육", "칠천팔백구십", "일백만", "일백만점일영이삼", "일억이백만일천삼십", ""}
+	input := []string{"", "12", "0123456", "7890", "1000000", "1000000.1023", "102001030", "-3", "100000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"}
+	want := []string{"", "일십이", "일십이만삼천사백오십육", "칠천팔백구십", "일백만", "일백만점일영이삼", "일억이백만일천삼십", "마이너스 삼", ""}
 
 	for i, v := range input {
 		output := NumberToHangul(v)
@@ -322,9 +434,113 @@ func TestNumberToHangul(t *testing.T) {
 	}
 }
 
+func TestNativeNumberToHangul(t *testing.T) {
+	tests := []struct {
+		number   int
+		short    bool
+		expected string
+	}{
+		{0, false, ""},
+		{1, false, "하나"},
+		{1, true, "한"},
+		{2, true, "두"},
+		{3, true, "세"},
+		{4, true, "네"},
+		{5, true, "다섯"},
+		{10, false, "열"},
+		{11, true, "열한"},
+		{20, false, "스물"},
+		{20, true, "스무"},
+		{21, true, "스물한"},
+		{47, false, "마흔일곱"},
+		{99, false, "아흔아홉"},
+		{100, false, ""},
+	}
+
+	for _, test := range tests {
+		result := NativeNumberToHangul(test.number, test.short)
+		if result != test.expected {
+			t.Errorf("NativeNumberToHangul(%d, %v) = %q; want %q", test.number, test.short, result, test.expected)
+		}
+	}
+}
+
+func TestCountToHangul(t *testing.T) {
+	tests := []struct {
+		number   int
+		counter  string
+		expected string
+	}{
+		{1, "개", "한 개"},
+		{2, "명", "두 명"},
+		{3, "살", "세 살"},
+		{20, "살", "스무 살"},
+		{25, "살", "스물다섯 살"},
+		{12, "시", "열두 시"},
+		{4, "마리", "네 마리"},
+		{0, "개", "영 개"},
+		{100, "개", "백 개"},
+		{123, "개", "백스물세 개"},
+		{1000, "명", "천 명"},
+		{30, "분", "삼십 분"},
+		{10, "분", "십 분"},
+		{15000, "원", "만오천 원"},
+		{2024, "년", "이천이십사 년"},
+		{3, "층", "삼 층"},
+		{-1, "개", ""},
+	}
+
+	for _, test := range tests {
+		result := CountToHangul(test.number, test.counter)
+		if result != test.expected {
+			t.Errorf("CountToHangul(%d, %q) = %q; want %q", test.number, test.counter, result, test.expected)
+		}
+	}
+}
+
+func TestOrdinal(t *testing.T) {
+	tests := []struct {
+		number   int
+		style    OrdinalStyle
+		expected string
+	}{
+		{1, OrdinalNative, "첫째"},
+		{2, OrdinalNative, "둘째"},
+		{3, OrdinalNative, "셋째"},
+		{4, OrdinalNative, "넷째"},
+		{10, OrdinalNative, "열째"},
+		{11, OrdinalNative, "열한째"},
+		{12, OrdinalNative, "열두째"},
+		{13, OrdinalNative, "열셋째"},
+		{20, OrdinalNative, "스무째"},
+		{21, OrdinalNative, "스물한째"},
+		{99, OrdinalNative, "아흔아홉째"},
+		{100, OrdinalNative, ""},
+		{0, OrdinalNative, ""},
+		{1, OrdinalCounter, "첫 번째"},
+		{2, OrdinalCounter, "두 번째"},
+		{3, OrdinalCounter, "세 번째"},
+		{20, OrdinalCounter, "스무 번째"},
+		{101, OrdinalCounter, "백한 번째"},
+		{1, OrdinalSinoCounter, "일 번째"},
+		{10, OrdinalSinoCounter, "십 번째"},
+		{3, OrdinalPrefix, "제3"},
+		{1, OrdinalPrefixHangul, "제일"},
+		{12, OrdinalPrefixHangul, "제십이"},
+		{-1, OrdinalPrefix, ""},
+	}
+
+	for _, test := range tests {
+		result := Ordinal(test.number, test.style)
+		if result != test.expected {
+			t.Errorf("Ordinal(%d, %d) = %q; want %q", test.number, test.style, result, test.expected)
+		}
+	}
+}
+
 func TestRomanize(t *testing.T) {
-	input := []string{"", "안녕하세요", "반갑습니다", "한글로", "로마자로"}
-	want := []string{"", "annyeonghaseyo", "bangapseupnida", "hangeulro", "romajaro"}
+	input := []string{"", "안녕하세요", "반갑습니다", "한글로", "로마자로", "신라", "같이", "종로", "왕십리", "별내", "해돋이", "좋고", "놓다", "잡혀", "좋아", "닭", "압구정", "백마", "서울 종로구", "묵호", "집현전", "먹힌"}
+	want := []string{"", "annyeonghaseyo", "bangapseumnida", "hangeullo", "romajaro", "silla", "gachi", "jongno", "wangsimni", "byeollae", "haedoji", "joko", "nota", "japyeo", "joa", "dak", "apgujeong", "baengma", "seoul jongnogu", "mukho", "jiphyeonjeon", "meokin"}
 
 	for i, v := range input {
 		output := Romanize(v)
@@ -332,6 +548,16 @@ func TestRomanize(t *testing.T) {
 			t.Errorf("Romanize(%q) = %q; want %q", v, output, want[i])
 		}
 	}
+
+	input = []string{"", "안녕하세요", "반갑습니다", "한글로", "로마자로", "신라"}
+	want = []string{"", "annyeonghaseyo", "bangapseupnida", "hangeulro", "romajaro", "sinra"}
+
+	for i, v := range input {
+		output := Romanize(v, true)
+		if output != want[i] {
+			t.Errorf("Romanize(%q, true) = %q; want %q", v, output, want[i])
+		}
+	}
 }
 
 func TestWeekday(t *testing.T) {
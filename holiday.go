@@ -0,0 +1,177 @@
+package gohangul
+
+import (
+	"sort"
+	"strconv"
+	"time"
+)
+
+// substituteRule 대체공휴일을 두는 경우
+type substituteRule int
+
+const (
+	substituteNone     substituteRule = iota
+	substituteSunday                  // 일요일이나 다른 공휴일과 겹치는 경우 (설날, 추석 연휴)
+	substituteWeekend                 // 토요일, 일요일과 겹치는 경우 (국경일 등)
+	substituteAnyOther                // 토요일, 일요일이나 다른 공휴일과 겹치는 경우 (어린이날)
+)
+
+// holidayRule 공휴일을 정하는 규칙
+type holidayRule struct {
+	names      []string // 연휴의 날마다의 이름
+	month, day int      // 첫날의 월, 일
+	lunar      bool     // 음력 날짜인지
+	offset     int      // month, day 로부터 연휴 첫날까지의 날수
+	substitute substituteRule
+	since      int // 대체공휴일을 두기 시작한 해
+}
+
+// 관공서의 공휴일에 관한 규정에 따른 공휴일 (일요일과 선거일, 임시공휴일은 빼고)
+var holidayRules = []holidayRule{
+	{names: []string{"신정"}, month: 1, day: 1},
+	{names: []string{"설날 전날", "설날", "설날 다음날"}, month: 1, day: 1, lunar: true, offset: -1, substitute: substituteSunday, since: 2014},
+	{names: []string{"삼일절"}, month: 3, day: 1, substitute: substituteWeekend, since: 2021},
+	{names: []string{"어린이날"}, month: 5, day: 5, substitute: substituteAnyOther, since: 2014},
+	{names: []string{"부처님오신날"}, month: 4, day: 8, lunar: true, substitute: substituteWeekend, since: 2023},
+	{names: []string{"현충일"}, month: 6, day: 6},
+	{names: []string{"광복절"}, month: 8, day: 15, substitute: substituteWeekend, since: 2021},
+	{names: []string{"추석 전날", "추석", "추석 다음날"}, month: 8, day: 15, lunar: true, offset: -1, substitute: substituteSunday, since: 2014},
+	{names: []string{"개천절"}, month: 10, day: 3, substitute: substituteWeekend, since: 2021},
+	{names: []string{"한글날"}, month: 10, day: 9, substitute: substituteWeekend, since: 2021},
+	{names: []string{"기독탄신일"}, month: 12, day: 25, substitute: substituteWeekend, since: 2023},
+}
+
+// Holiday 공휴일
+type Holiday struct {
+	Name       string    // 공휴일의 이름 (추석, 삼일절 대체공휴일)
+	Date       time.Time // 날짜 (0시)
+	Substitute bool      // 대체공휴일인지
+}
+
+// Holidays 양력 연도의 공휴일을 날짜 차례로 반환합니다.
+// 공휴일은 현행 규정으로 계산하며, 설날, 추석, 부처님오신날은 음력으로, 대체공휴일은 도입된 해부터 둡니다.
+// 일요일과 선거일, 임시공휴일은 넣지 않으며, 음력으로 계산할 수 없는 연도는 ErrTimeRange 오류를 반환합니다.
+//
+//	Holidays(2026, time.Local) // 신정, 설날 전날, 설날, 설날 다음날, 삼일절, 삼일절 대체공휴일, ...
+func Holidays(year int, loc *time.Location) ([]Holiday, error) {
+	if year < lunarFirstYear || year > lunarLastYear {
+		return nil, &TimeError{Func: "Holidays", Value: strconv.Itoa(year), Err: ErrTimeRange}
+	}
+
+	type group struct {
+		rule  holidayRule
+		dates []time.Time
+	}
+	groups := make([]group, 0, len(holidayRules))
+	taken := make(map[int]int)
+	var holidays []Holiday
+
+	for _, rule := range holidayRules {
+		first := time.Date(year, time.Month(rule.month), rule.day, 0, 0, 0, 0, loc)
+		if rule.lunar {
+			t, err := LunarToSolar(LunarDate{Year: year, Month: rule.month, Day: rule.day}, loc)
+			if err != nil {
+				return nil, &TimeError{Func: "Holidays", Value: strconv.Itoa(year), Err: ErrTimeRange}
+			}
+			first = t
+		}
+		first = first.AddDate(0, 0, rule.offset)
+
+		g := group{rule: rule}
+		for i, name := range rule.names {
+			date := first.AddDate(0, 0, i)
+			g.dates = append(g.dates, date)
+			taken[dateKey(date)]++
+			holidays = append(holidays, Holiday{Name: name, Date: date})
+		}
+		groups = append(groups, g)
+	}
+
+	for _, g := range groups {
+		if g.rule.substitute == substituteNone || year < g.rule.since {
+			continue
+		}
+
+		overlaps := 0
+		for _, date := range g.dates {
+			weekday := date.Weekday()
+			switch {
+			case weekday == time.Sunday,
+				weekday == time.Saturday && g.rule.substitute != substituteSunday,
+				taken[dateKey(date)] > 1 && g.rule.substitute != substituteWeekend:
+				overlaps++
+			}
+		}
+
+		date := g.dates[len(g.dates)-1]
+		for ; overlaps > 0; overlaps-- {
+			date = date.AddDate(0, 0, 1)
+			for date.Weekday() == time.Saturday || date.Weekday() == time.Sunday || taken[dateKey(date)] > 0 {
+				date = date.AddDate(0, 0, 1)
+			}
+			taken[dateKey(date)]++
+			holidays = append(holidays, Holiday{Name: g.rule.names[len(g.rule.names)/2] + " 대체공휴일", Date: date, Substitute: true})
+		}
+	}
+
+	sort.SliceStable(holidays, func(i, j int) bool {
+		return holidays[i].Date.Before(holidays[j].Date)
+	})
+	return holidays, nil
+}
+
+// HolidaysOn 날짜의 공휴일을 반환합니다. 공휴일이 겹치면 모두 반환하고, 공휴일이 아니면 nil 을 반환합니다.
+// t 의 시간대에서의 날짜를 씁니다.
+//
+//	HolidaysOn(time.Date(2026, 9, 25, 0, 0, 0, 0, time.Local)) // [추석]
+func HolidaysOn(t time.Time) []Holiday {
+	y, m, d := t.Date()
+	holidays, err := Holidays(y, t.Location())
+	if err != nil {
+		return nil
+	}
+
+	var result []Holiday
+	for _, h := range holidays {
+		if hy, hm, hd := h.Date.Date(); hy == y && hm == m && hd == d {
+			result = append(result, h)
+		}
+	}
+	return result
+}
+
+// IsHoliday 날짜가 공휴일(대체공휴일 포함)인지 확인합니다. 일요일은 공휴일로 보지 않습니다.
+func IsHoliday(t time.Time) bool {
+	return len(HolidaysOn(t)) > 0
+}
+
+// IsBusinessDay 날짜가 토요일, 일요일, 공휴일이 아닌 평일인지 확인합니다.
+func IsBusinessDay(t time.Time) bool {
+	if t.Weekday() == time.Saturday || t.Weekday() == time.Sunday {
+		return false
+	}
+	return !IsHoliday(t)
+}
+
+// AddBusinessDays 날짜에 평일을 n 일 더합니다. n 이 음수이면 앞으로 셉니다.
+//
+//	AddBusinessDays(time.Date(2026, 9, 23, 0, 0, 0, 0, time.Local), 1) // 2026-09-28 (추석 연휴와 주말을 건너뜁니다)
+func AddBusinessDays(t time.Time, n int) time.Time {
+	step := 1
+	if n < 0 {
+		step, n = -1, -n
+	}
+	for n > 0 {
+		t = t.AddDate(0, 0, step)
+		if IsBusinessDay(t) {
+			n--
+		}
+	}
+	return t
+}
+
+// dateKey 날짜를 연월일의 정수로 바꿉니다. (20260925)
+func dateKey(t time.Time) int {
+	y, m, d := t.Date()
+	return y*10000 + int(m)*100 + d
+}
@@ -0,0 +1,139 @@
+package gohangul
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func BenchmarkHolidays(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		Holidays(2026, time.UTC)
+	}
+}
+
+func TestHolidays(t *testing.T) {
+	tests := []struct {
+		year     int
+		expected []string
+	}{
+		{2024, []string{
+			"01-01 신정", "02-09 설날 전날", "02-10 설날", "02-11 설날 다음날", "02-12 설날 대체공휴일",
+			"03-01 삼일절", "05-05 어린이날", "05-06 어린이날 대체공휴일", "05-15 부처님오신날", "06-06 현충일",
+			"08-15 광복절", "09-16 추석 전날", "09-17 추석", "09-18 추석 다음날", "10-03 개천절",
+			"10-09 한글날", "12-25 기독탄신일",
+		}},
+		{2025, []string{
+			"01-01 신정", "01-28 설날 전날", "01-29 설날", "01-30 설날 다음날", "03-01 삼일절",
+			"03-03 삼일절 대체공휴일", "05-05 어린이날", "05-05 부처님오신날", "05-06 어린이날 대체공휴일", "06-06 현충일",
+			"08-15 광복절", "10-03 개천절", "10-05 추석 전날", "10-06 추석", "10-07 추석 다음날",
+			"10-08 추석 대체공휴일", "10-09 한글날", "12-25 기독탄신일",
+		}},
+		{2026, []string{
+			"01-01 신정", "02-16 설날 전날", "02-17 설날", "02-18 설날 다음날", "03-01 삼일절",
+			"03-02 삼일절 대체공휴일", "05-05 어린이날", "05-24 부처님오신날", "05-25 부처님오신날 대체공휴일", "06-06 현충일",
+			"08-15 광복절", "08-17 광복절 대체공휴일", "09-24 추석 전날", "09-25 추석", "09-26 추석 다음날",
+			"10-03 개천절", "10-05 개천절 대체공휴일", "10-09 한글날", "12-25 기독탄신일",
+		}},
+		// 대체공휴일 도입 전
+		{2012, []string{
+			"01-01 신정", "01-22 설날 전날", "01-23 설날", "01-24 설날 다음날", "03-01 삼일절",
+			"05-05 어린이날", "05-28 부처님오신날", "06-06 현충일", "08-15 광복절", "09-29 추석 전날",
+			"09-30 추석", "10-01 추석 다음날", "10-03 개천절", "10-09 한글날", "12-25 기독탄신일",
+		}},
+	}
+
+	for _, test := range tests {
+		holidays, err := Holidays(test.year, time.UTC)
+		if err != nil {
+			t.Fatalf("Holidays(%d) error = %v", test.year, err)
+		}
+
+		result := make([]string, len(holidays))
+		for i, h := range holidays {
+			result[i] = h.Date.Format("01-02") + " " + h.Name
+		}
+		if len(result) != len(test.expected) {
+			t.Errorf("Holidays(%d) = %q; want %q", test.year, result, test.expected)
+			continue
+		}
+		for i := range result {
+			if result[i] != test.expected[i] {
+				t.Errorf("Holidays(%d)[%d] = %q; want %q", test.year, i, result[i], test.expected[i])
+			}
+		}
+	}
+
+	for _, year := range []int{1899, 2101} {
+		if _, err := Holidays(year, time.UTC); !errors.Is(err, ErrTimeRange) {
+			t.Errorf("Holidays(%d) error = %v; want %v", year, err, ErrTimeRange)
+		}
+	}
+}
+
+func TestHolidaysOn(t *testing.T) {
+	kst := time.FixedZone("KST", 9*60*60)
+	tests := []struct {
+		date     time.Time
+		expected []string
+	}{
+		{time.Date(2026, 9, 25, 0, 0, 0, 0, kst), []string{"추석"}},
+		{time.Date(2025, 5, 5, 15, 0, 0, 0, kst), []string{"어린이날", "부처님오신날"}},
+		{time.Date(2026, 8, 17, 0, 0, 0, 0, kst), []string{"광복절 대체공휴일"}},
+		{time.Date(2026, 10, 18, 0, 0, 0, 0, kst), nil},
+	}
+
+	for _, test := range tests {
+		holidays := HolidaysOn(test.date)
+		if len(holidays) != len(test.expected) {
+			t.Errorf("HolidaysOn(%v) = %v; want %q", test.date, holidays, test.expected)
+			continue
+		}
+		for i, h := range holidays {
+			if h.Name != test.expected[i] || h.Date.Location() != kst {
+				t.Errorf("HolidaysOn(%v)[%d] = %v; want %q", test.date, i, h, test.expected[i])
+			}
+		}
+		if IsHoliday(test.date) != (test.expected != nil) {
+			t.Errorf("IsHoliday(%v) = %t; want %t", test.date, !(test.expected != nil), test.expected != nil)
+		}
+	}
+}
+
+func TestIsBusinessDay(t *testing.T) {
+	tests := []struct {
+		date     time.Time
+		expected bool
+	}{
+		{time.Date(2026, 9, 23, 0, 0, 0, 0, time.UTC), true},
+		{time.Date(2026, 9, 24, 0, 0, 0, 0, time.UTC), false},
+		{time.Date(2026, 9, 27, 0, 0, 0, 0, time.UTC), false},
+		{time.Date(2026, 10, 5, 0, 0, 0, 0, time.UTC), false},
+		{time.Date(2026, 10, 6, 0, 0, 0, 0, time.UTC), true},
+	}
+
+	for _, test := range tests {
+		if result := IsBusinessDay(test.date); result != test.expected {
+			t.Errorf("IsBusinessDay(%v) = %t; want %t", test.date, result, test.expected)
+		}
+	}
+}
+
+func TestAddBusinessDays(t *testing.T) {
+	tests := []struct {
+		date     time.Time
+		n        int
+		expected string
+	}{
+		{time.Date(2026, 9, 23, 0, 0, 0, 0, time.UTC), 1, "2026-09-28"},
+		{time.Date(2026, 9, 28, 0, 0, 0, 0, time.UTC), -1, "2026-09-23"},
+		{time.Date(2026, 10, 2, 0, 0, 0, 0, time.UTC), 2, "2026-10-07"},
+		{time.Date(2026, 10, 2, 0, 0, 0, 0, time.UTC), 0, "2026-10-02"},
+	}
+
+	for _, test := range tests {
+		if result := AddBusinessDays(test.date, test.n).Format("2006-01-02"); result != test.expected {
+			t.Errorf("AddBusinessDays(%v, %d) = %s; want %s", test.date, test.n, result, test.expected)
+		}
+	}
+}
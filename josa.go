@@ -0,0 +1,478 @@
+package gohangul
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"text/template"
+	"unicode"
+	"unicode/utf8"
+)
+
+// 단어 끝에서 건너뛰는 문장 부호
+const sentencePunctuation = ".,!?;:…"
+
+var (
+	// 로마자 -> 한글 읽기
+	latinLetterHanguls = map[rune]string{
+		'A': "에이",
+		'B': "비",
+		'C': "씨",
+		'D': "디",
+		'E': "이",
+		'F': "에프",
+		'G': "지",
+		'H': "에이치",
+		'I': "아이",
+		'J': "제이",
+		'K': "케이",
+		'L': "엘",
+		'M': "엠",
+		'N': "엔",
+		'O': "오",
+		'P': "피",
+		'Q': "큐",
+		'R': "알",
+		'S': "에스",
+		'T': "티",
+		'U': "유",
+		'V': "브이",
+		'W': "더블유",
+		'X': "엑스",
+		'Y': "와이",
+		'Z': "제트",
+	}
+
+	// 기호 -> 한글 읽기
+	symbolHanguls = map[rune]string{
+		'%': "퍼센트",
+		'℃': "도",
+		'°': "도",
+		'$': "달러",
+		'₩': "원",
+		'€': "유로",
+		'¥': "엔",
+		'+': "플러스",
+	}
+
+	// 닫는 괄호 -> 여는 괄호
+	bracketPairs = map[rune]rune{
+		')': '(',
+		']': '[',
+		'}': '{',
+	}
+
+	// 건너뛰는 닫는 문장 부호
+	closingMarks = map[rune]bool{
+		'"':  true,
+		'\'': true,
+		'”':  true,
+		'’':  true,
+		'」':  true,
+		'』':  true,
+		'》':  true,
+		'〉':  true,
+		'>':  true,
+	}
+
+	// 영어 어말 파열음 -> 받침
+	englishStopJongseong = map[byte]Jamo{
+		'c': 'ㄱ',
+		'k': 'ㄱ',
+		'p': 'ㅂ',
+		't': 'ㅅ',
+	}
+
+	// 영어 장모음 철자
+	englishLongVowels = []string{"ee", "ea", "ai", "oa", "ei"}
+
+	// 문자열 안의 조사 표기 (을/를, 을(를), (을)를, (으)로 등)
+	josaMarkers = buildJosaMarkers()
+
+	// 조사의 형태 -> 조사 종류, 긴 형태부터 정렬
+	josaWritten = buildJosaWritten()
+
+	// 한글 단어 뒤에서 고쳐도 되는 조사
+	// 단어의 끝 음절과 헷갈리기 쉬운 '이, 가, 는, 과, 로, 나' 등은 고치지 않습니다.
+	safeJosaCorrections = map[string]bool{
+		"를":    true,
+		"을":    true,
+		"와":    true,
+		"예요":   true,
+		"으로":   true,
+		"으로서":  true,
+		"으로써":  true,
+		"으로부터": true,
+		"으로는":  true,
+		"으로도":  true,
+		"으로만":  true,
+		"으로의":  true,
+	}
+
+	// 숫자와 로마자 뒤에서 safeJosaCorrections 에 더해 고쳐도 되는 조사
+	// 숫자 뒤의 '여, 다, 면, 고' 등은 조사가 아닌 말(10여 명, 3다 4)일 수 있으므로 고치지 않습니다.
+	symbolJosaCorrections = map[string]bool{
+		"이":   true,
+		"가":   true,
+		"은":   true,
+		"는":   true,
+		"과":   true,
+		"로":   true,
+		"로서":  true,
+		"로써":  true,
+		"로부터": true,
+		"로는":  true,
+		"로도":  true,
+		"로만":  true,
+		"로의":  true,
+		"이랑":  true,
+		"랑":   true,
+		"이에요": true,
+	}
+
+	// 조사가 아닌 '을'로 끝나는 말
+	josaExceptions = []string{
+		"마을", "가을", "노을", "고을",
+		"지을", "나을", "부을", "이을", "그을", "저을",
+	}
+)
+
+// josaText 문자열 안에 적힌 조사(를, 을/를)와 그 조사의 형태
+type josaText struct {
+	text string
+	form josaForm
+}
+
+// JosaFormat 템플릿의 {이름}을 값으로 바꾸고, 바로 뒤의 조사 표기를 값에 맞는 조사로 바꿉니다.
+// 조사 표기는 '을/를', '을(를)', '(을)를', '(으)로'와 같이 적습니다.
+// 값이 없는 {이름}은 그대로 둡니다.
+//
+//	JosaFormat("{user}님이 {item}을/를 구매했습니다", map[string]any{"user": "철수", "item": "사과"})
+//	// 철수님이 사과를 구매했습니다
+func JosaFormat(format string, data map[string]any) string {
+	var sb strings.Builder
+	sb.Grow(len(format))
+
+	for i := 0; i < len(format); {
+		if format[i] != '{' {
+			sb.WriteByte(format[i])
+			i++
+			continue
+		}
+
+		end := strings.IndexByte(format[i:], '}')
+		if end < 0 {
+			sb.WriteString(format[i:])
+			break
+		}
+
+		key := format[i+1 : i+end]
+		v, ok := data[key]
+		if !ok {
+			sb.WriteString(format[i : i+end+1])
+			i += end + 1
+			continue
+		}
+
+		value := fmt.Sprint(v)
+		sb.WriteString(value)
+		i += end + 1
+		i += writeJosaMarker(&sb, value, format[i:])
+	}
+	return sb.String()
+}
+
+// JosaSprintf fmt.Sprintf 처럼 서식 문자열을 채우고, 서식 지정자 바로 뒤의 조사 표기를 값에 맞는 조사로 바꿉니다.
+// 조사 표기는 JosaFormat 과 같습니다. 인자 순서 지정([n])은 지원하지 않습니다.
+//
+//	JosaSprintf("%s(을)를 %d개 샀다", "귤", 3) // 귤을 3개 샀다
+func JosaSprintf(format string, args ...any) string {
+	var sb strings.Builder
+	sb.Grow(len(format))
+	argIndex := 0
+
+	for i := 0; i < len(format); {
+		if format[i] != '%' {
+			sb.WriteByte(format[i])
+			i++
+			continue
+		}
+		if i+1 < len(format) && format[i+1] == '%' {
+			sb.WriteByte('%')
+			i += 2
+			continue
+		}
+
+		end := i + 1
+		for end < len(format) && strings.IndexByte("+-# 0123456789.", format[end]) >= 0 {
+			end++
+		}
+		if end >= len(format) {
+			sb.WriteString(format[i:])
+			break
+		}
+
+		var value string
+		if argIndex < len(args) {
+			value = fmt.Sprintf(format[i:end+1], args[argIndex])
+		} else {
+			value = "%!" + format[end:end+1] + "(MISSING)"
+		}
+		argIndex++
+
+		sb.WriteString(value)
+		i = end + 1
+		i += writeJosaMarker(&sb, value, format[i:])
+	}
+	return sb.String()
+}
+
+// JosaFuncMap text/template 에서 사용할 조사 함수를 반환합니다.
+//
+//	{{josa .User "이/가"}}     // 철수가
+//	{{josaPick .Item "을/를"}} // 를
+func JosaFuncMap() template.FuncMap {
+	return template.FuncMap{
+		"josa": func(word any, josaType string) string {
+			return Josa(fmt.Sprint(word), josaType)
+		},
+		"josaPick": func(word any, josaType string) string {
+			return JosaPick(fmt.Sprint(word), josaType)
+		},
+	}
+}
+
+// CorrectJosa 문장에서 앞말과 맞지 않는 조사를 찾아 고칩니다.
+// 조사는 공백으로 나눈 어절의 끝에서만 찾으며, 뒤따르는 문장 부호는 그대로 둡니다.
+// 앞말이 숫자, 로마자, 따옴표 등으로 끝나면 모든 조사를 고치고,
+// 한글로 끝나면 단어의 일부와 헷갈리지 않는 조사만 고칩니다.
+//
+//	CorrectJosa("사과을 먹었다") // 사과를 먹었다
+func CorrectJosa(text string) string {
+	var sb strings.Builder
+	sb.Grow(len(text))
+
+	for len(text) > 0 {
+		i := strings.IndexFunc(text, unicode.IsSpace)
+		if i < 0 {
+			i = len(text)
+		}
+		sb.WriteString(correctJosaToken(text[:i]))
+		text = text[i:]
+
+		j := strings.IndexFunc(text, func(r rune) bool { return !unicode.IsSpace(r) })
+		if j < 0 {
+			j = len(text)
+		}
+		sb.WriteString(text[:j])
+		text = text[j:]
+	}
+	return sb.String()
+}
+
+// correctJosaToken 어절 끝의 조사를 앞말에 맞게 고칩니다.
+func correctJosaToken(token string) string {
+	core := strings.TrimRightFunc(token, func(r rune) bool {
+		return closingMarks[r] || bracketPairs[r] != 0 || strings.ContainsRune(sentencePunctuation, r)
+	})
+	tail := token[len(core):]
+
+	for _, w := range josaWritten {
+		stem, ok := strings.CutSuffix(core, w.text)
+		if !ok || stem == "" {
+			continue
+		}
+
+		correct := w.form.pick(stem)
+		if correct == w.text {
+			return token
+		}
+
+		lastRune, _ := utf8.DecodeLastRuneInString(stem)
+		if lastRune >= baseHangul && lastRune <= lastHangul {
+			if !safeJosaCorrections[w.text] {
+				return token
+			}
+			for _, v := range josaExceptions {
+				if strings.HasSuffix(core, v) {
+					return token
+				}
+			}
+		} else if !safeJosaCorrections[w.text] && !symbolJosaCorrections[w.text] {
+			return token
+		}
+		return stem + correct + tail
+	}
+	return token
+}
+
+// writeJosaMarker rest 가 조사 표기로 시작하면 word 에 맞는 조사를 쓰고 조사 표기의 길이를 반환합니다.
+func writeJosaMarker(sb *strings.Builder, word, rest string) int {
+	for _, m := range josaMarkers {
+		if strings.HasPrefix(rest, m.text) {
+			sb.WriteString(m.form.pick(word))
+			return len(m.text)
+		}
+	}
+	return 0
+}
+
+// buildJosaMarkers josaTable 의 조사로 만들 수 있는 조사 표기를 긴 것부터 정렬해 반환합니다.
+func buildJosaMarkers() []josaText {
+	var markers []josaText
+	seen := make(map[string]bool)
+
+	for josaType, form := range josaTable {
+		a, b := form.batchim, form.noBatchim
+		texts := []string{josaType, a + "/" + b, b + "/" + a, a + "(" + b + ")", b + "(" + a + ")", "(" + a + ")" + b}
+		if prefix, ok := strings.CutSuffix(a, b); ok && prefix != "" {
+			texts = append(texts, "("+prefix+")"+b)
+		}
+
+		for _, text := range texts {
+			if !seen[text] {
+				seen[text] = true
+				markers = append(markers, josaText{text: text, form: form})
+			}
+		}
+	}
+
+	sort.Slice(markers, func(i, j int) bool {
+		if len(markers[i].text) != len(markers[j].text) {
+			return len(markers[i].text) > len(markers[j].text)
+		}
+		return markers[i].text < markers[j].text
+	})
+	return markers
+}
+
+// lastJongseong 문자열을 읽었을 때 마지막 음절의 받침을 반환합니다.
+// 숫자는 한자어 수사로, 로마자는 알파벳 이름이나 영어 발음으로 읽으며,
+// 끝의 닫는 괄호와 따옴표는 건너뜁니다. 받침이 없으면 0을 반환합니다.
+func lastJongseong(str string) Jamo {
+	str = trimClosing(str)
+	lastRune, _ := utf8.DecodeLastRuneInString(str)
+
+	switch {
+	case lastRune >= baseHangul && lastRune <= lastHangul:
+		return Disassemble(string(lastRune)).At(0).Jongseong.toLetter()
+	case lastRune >= '0' && lastRune <= '9':
+		return numberJongseong(str)
+	case isLatinLetter(lastRune):
+		return latinJongseong(str)
+	}
+	if v, ok := symbolHanguls[lastRune]; ok {
+		return lastJongseong(v)
+	}
+	return 0
+}
+
+// trimClosing 문자열 끝의 공백, 문장 부호, 닫는 따옴표와 괄호로 묶인 부분을 잘라냅니다.
+// 괄호 앞에 다른 문자가 있으면 괄호로 묶인 부분 전체를 잘라냅니다. (사과(沙果) -> 사과, 3. -> 3)
+func trimClosing(str string) string {
+	for {
+		str = strings.TrimRightFunc(str, unicode.IsSpace)
+		lastRune, size := utf8.DecodeLastRuneInString(str)
+
+		if open, ok := bracketPairs[lastRune]; ok {
+			if i := strings.LastIndexByte(str, byte(open)); i > 0 {
+				str = str[:i]
+			} else {
+				str = str[:len(str)-size]
+			}
+			continue
+		}
+		if closingMarks[lastRune] || strings.ContainsRune(sentencePunctuation, lastRune) {
+			str = str[:len(str)-size]
+			continue
+		}
+		return str
+	}
+}
+
+// numberJongseong 문자열 끝의 숫자를 한자어 수사로 읽었을 때의 받침을 반환합니다.
+func numberJongseong(str string) Jamo {
+	start := len(str)
+	for start > 0 && strings.ContainsRune("0123456789.,", rune(str[start-1])) {
+		start--
+	}
+
+	number := strings.TrimRight(str[start:], ".,")
+	if hangul := NumberToHangul(number); hangul != "" {
+		return lastJongseong(hangul)
+	}
+	return lastJongseong(numberHanguls[number[len(number)-1]-'0'])
+}
+
+// latinJongseong 문자열 끝의 로마자 단어를 읽었을 때의 받침을 반환합니다.
+// 모두 대문자이거나 한 글자이면 알파벳 이름으로, 그 외에는 영어 단어로 읽습니다.
+func latinJongseong(str string) Jamo {
+	start := len(str)
+	for start > 0 && isLatinLetter(rune(str[start-1])) {
+		start--
+	}
+
+	word := str[start:]
+	if len(word) == 1 || strings.ToUpper(word) == word {
+		return lastJongseong(latinLetterHanguls[unicode.ToUpper(rune(word[len(word)-1]))])
+	}
+	return englishJongseong(strings.ToLower(word))
+}
+
+// englishJongseong 영어 단어를 외래어 표기법에 따라 읽었을 때의 받침을 추정합니다.
+func englishJongseong(word string) Jamo {
+	last := word[len(word)-1]
+
+	switch {
+	case strings.HasSuffix(word, "ng"):
+		return 'ㅇ'
+	case last == 'm', strings.HasSuffix(word, "me"):
+		return 'ㅁ'
+	case last == 'n', strings.HasSuffix(word, "ne"):
+		return 'ㄴ'
+	case last == 'l', strings.HasSuffix(word, "le"):
+		return 'ㄹ'
+	}
+
+	// 짧은 모음 뒤의 어말 무성 파열음은 받침으로 적습니다. (book 북, chat 챗, app 앱)
+	jong, ok := englishStopJongseong[last]
+	if !ok {
+		return 0
+	}
+	stem := strings.TrimRight(word, string(last))
+	if last == 'k' {
+		stem = strings.TrimSuffix(stem, "c")
+	}
+	if stem == "" || !strings.ContainsRune("aeiou", rune(stem[len(stem)-1])) {
+		return 0
+	}
+	for _, v := range englishLongVowels {
+		if strings.HasSuffix(stem, v) {
+			return 0
+		}
+	}
+	return jong
+}
+
+// buildJosaWritten josaTable 의 조사 형태를 긴 것부터 정렬해 반환합니다.
+func buildJosaWritten() []josaText {
+	types := make([]string, 0, len(josaTable))
+	for josaType := range josaTable {
+		types = append(types, josaType)
+	}
+	sort.Strings(types)
+
+	var written []josaText
+	for _, josaType := range types {
+		form := josaTable[josaType]
+		written = append(written,
+			josaText{text: form.batchim, form: form},
+			josaText{text: form.noBatchim, form: form},
+		)
+	}
+
+	sort.SliceStable(written, func(i, j int) bool {
+		return len(written[i].text) > len(written[j].text)
+	})
+	return written
+}
@@ -0,0 +1,122 @@
+package gohangul
+
+import (
+	"strings"
+	"testing"
+	"text/template"
+)
+
+func BenchmarkJosaFormat(b *testing.B) {
+	data := map[string]any{"user": "철수", "item": "사과"}
+	for i := 0; i < b.N; i++ {
+		JosaFormat("{user}님이 {item}을/를 구매했습니다", data)
+	}
+}
+
+func TestJosaFormat(t *testing.T) {
+	data := map[string]any{"user": "철수", "item": "사과", "fruit": "귤", "city": "서울", "count": 3}
+	tests := []struct {
+		format   string
+		expected string
+	}{
+		{"", ""},
+		{"{user}님이 {item}을/를 구매했습니다", "철수님이 사과를 구매했습니다"},
+		{"{fruit}을(를) 먹었다", "귤을 먹었다"},
+		{"{item}(을)를 먹었다", "사과를 먹었다"},
+		{"{city}(으)로 간다", "서울로 간다"},
+		{"{fruit}(이)나 {item}(이)나", "귤이나 사과나"},
+		{"{count}(이)가 남았다", "3이 남았다"},
+		{"{user}이 왔다", "철수이 왔다"},
+		{"{unknown}을/를 {item}", "{unknown}을/를 사과"},
+		{"{item", "{item"},
+	}
+
+	for _, test := range tests {
+		result := JosaFormat(test.format, data)
+		if result != test.expected {
+			t.Errorf("JosaFormat(%q) = %q; want %q", test.format, result, test.expected)
+		}
+	}
+}
+
+func TestJosaSprintf(t *testing.T) {
+	tests := []struct {
+		format   string
+		args     []any
+		expected string
+	}{
+		{"", nil, ""},
+		{"%s(을)를 %d개 샀다", []any{"귤", 3}, "귤을 3개 샀다"},
+		{"%s은/는 %s(으)로 갔다", []any{"영희", "부산"}, "영희는 부산으로 갔다"},
+		{"%d(이)가 정답", []any{3}, "3이 정답"},
+		{"%5.1f%%", []any{12.34}, " 12.3%"},
+		{"%s와/과 %s", []any{"사과"}, "사과와 %!s(MISSING)"},
+	}
+
+	for _, test := range tests {
+		result := JosaSprintf(test.format, test.args...)
+		if result != test.expected {
+			t.Errorf("JosaSprintf(%q, %v) = %q; want %q", test.format, test.args, result, test.expected)
+		}
+	}
+}
+
+func TestJosaFuncMap(t *testing.T) {
+	tmpl := template.Must(template.New("").Funcs(JosaFuncMap()).Parse(`{{josa .User "이/가"}} {{.Item}}{{josaPick .Item "을/를"}} 샀다`))
+	want := "철수가 귤을 샀다"
+
+	var sb strings.Builder
+	if err := tmpl.Execute(&sb, map[string]string{"User": "철수", "Item": "귤"}); err != nil {
+		t.Fatal(err)
+	}
+	if got := sb.String(); got != want {
+		t.Errorf("JosaFuncMap() = %q, want %q", got, want)
+	}
+}
+
+func TestCorrectJosa(t *testing.T) {
+	tests := []struct {
+		text     string
+		expected string
+	}{
+		{"", ""},
+		{"사과을 먹었다", "사과를 먹었다"},
+		{"귤를 먹었다", "귤을 먹었다"},
+		{"사과를 먹었다", "사과를 먹었다"},
+		{"서울으로 간다", "서울로 간다"},
+		{"학교으로부터 왔다", "학교로부터 왔다"},
+		{"귤와 사과", "귤과 사과"},
+		{"책예요.", "책이에요."},
+		{"3가 남았다", "3이 남았다"},
+		{"Apple를 샀다", "Apple을 샀다"},
+		{"HTML으로 만든다", "HTML로 만든다"},
+		{"'사과'을 샀다", "'사과'를 샀다"},
+		{"\"사과을\" 샀다", "\"사과를\" 샀다"},
+		{"사과을,  귤를\n먹었다", "사과를,  귤을\n먹었다"},
+		// 조사와 끝 음절이 같은 단어는 고치지 않습니다.
+		{"나이가 많다", "나이가 많다"},
+		{"마을에 가을이 왔다", "마을에 가을이 왔다"},
+		{"시골마을 노을", "시골마을 노을"},
+		{"집을 지을 땅", "집을 지을 땅"},
+		{"먹는 사람", "먹는 사람"},
+		{"사과 평가", "사과 평가"},
+		{"경로 안내", "경로 안내"},
+		{"사이랑 사랑", "사이랑 사랑"},
+		{"을", "을"},
+		// 숫자 뒤의 조사가 아닌 말은 고치지 않습니다.
+		{"10여 명이 왔다", "10여 명이 왔다"},
+		{"3다 4", "3다 4"},
+		{"3면 된다", "3면 된다"},
+		{"2고 3", "2고 3"},
+		{"OK여 NO여", "OK여 NO여"},
+		{"3은 홀수", "3은 홀수"},
+		{"1는 홀수", "1은 홀수"},
+	}
+
+	for _, test := range tests {
+		result := CorrectJosa(test.text)
+		if result != test.expected {
+			t.Errorf("CorrectJosa(%q) = %q; want %q", test.text, result, test.expected)
+		}
+	}
+}
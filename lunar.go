@@ -0,0 +1,253 @@
+package gohangul
+
+import (
+	"sort"
+	"strconv"
+	"time"
+)
+
+// 음력의 범위
+const (
+	lunarFirstYear = 1900
+	lunarLastYear  = 2100
+)
+
+// 음력 날짜의 레이아웃
+const (
+	LayoutLunar       = "음력 {L}{M}월 {D}일"      // 음력 8월 15일
+	LayoutLunarSpoken = "음력 {L}{MK} {DK}"      // 음력 팔월 보름
+	LayoutLunarFull   = "음력 {Y}년 {L}{M}월 {D}일" // 음력 2026년 8월 15일
+)
+
+var (
+	// 음력 1900년 1월 1일의 양력 날짜
+	lunarEpoch = time.Date(1900, 1, 31, 0, 0, 0, 0, time.UTC)
+
+	// 음력 1900년부터 2100년까지의 해마다의 달 정보
+	// 0~12 비트: 윤달을 포함한 차례대로의 큰달(30일) 여부, 13~16 비트: 윤달 (없으면 0)
+	// 동지가 든 달을 11월로, 중기가 없는 첫 달을 윤달로 두고 한국 표준시로 합삭일을 정한
+	// 한국천문연구원 역서의 방식으로 계산한 값입니다.
+	lunarYearInfo = [lunarLastYear - lunarFirstYear + 1]uint32{
+		0x116d2, 0x00752, 0x00ea5, 0x0ad4a, 0x0054b, 0x00a97, 0x09556, 0x0056a,
+		0x00b55, 0x05752, 0x00752, 0x0d725, 0x00b25, 0x00a4b, 0x0b29b, 0x00aad,
+		0x0056a, 0x04b69, 0x00ba9, 0x0fb52, 0x00d92, 0x00d25, 0x0ba4d, 0x00956,
+		0x002b5, 0x095ad, 0x006d4, 0x00da9, 0x05d92, 0x00e92, 0x0cd26, 0x00527,
+		0x00a57, 0x0b2b6, 0x00ada, 0x006d4, 0x06ea9, 0x00749, 0x0f693, 0x00a93,
+		0x0052b, 0x0ca5b, 0x0096d, 0x00b6a, 0x09b54, 0x00ba4, 0x00b49, 0x05a93,
+		0x00a95, 0x0f52b, 0x0052d, 0x00aad, 0x0b56a, 0x00db2, 0x00da4, 0x07d49,
+		0x00d4a, 0x11a95, 0x00a96, 0x00556, 0x0cab5, 0x00ad5, 0x006d2, 0x08ea5,
+		0x00ea5, 0x00e4a, 0x06c96, 0x00a9b, 0x0f556, 0x0056a, 0x00b59, 0x0b752,
+		0x00752, 0x00725, 0x0964b, 0x00a4b, 0x112ab, 0x002ad, 0x0056b, 0x0cb69,
+		0x00da9, 0x00d92, 0x09b25, 0x00d25, 0x15a4d, 0x00a56, 0x002b6, 0x0d5ad,
+		0x006d4, 0x00da9, 0x0bd92, 0x00e92, 0x00d26, 0x06a56, 0x00a57, 0x112b6,
+		0x00b5a, 0x006d4, 0x0aec9, 0x00749, 0x00693, 0x09527, 0x0052b, 0x00a5b,
+		0x0555a, 0x0036a, 0x0fb55, 0x00ba4, 0x00b49, 0x0ba93, 0x00a95, 0x0052d,
+		0x06a5d, 0x00aad, 0x135aa, 0x005d2, 0x00da5, 0x0bd4a, 0x00d4a, 0x00a95,
+		0x0952d, 0x00556, 0x00ab5, 0x055aa, 0x006d2, 0x0cea5, 0x00ea5, 0x00e4a,
+		0x0ac96, 0x00c9b, 0x0055a, 0x06ad5, 0x00b69, 0x17752, 0x00752, 0x00b25,
+		0x0d64b, 0x00a4b, 0x004ab, 0x0a55b, 0x0056d, 0x00b69, 0x05b52, 0x00d92,
+		0x0fd25, 0x00d25, 0x00a4d, 0x0b4ad, 0x002b6, 0x005b5, 0x06da9, 0x00ea9,
+		0x11d92, 0x00e92, 0x00d26, 0x0ca56, 0x00a57, 0x004d6, 0x086b5, 0x006d5,
+		0x00ec9, 0x06e92, 0x00693, 0x0f52b, 0x0052b, 0x00a5b, 0x0b55a, 0x0056a,
+		0x00b55, 0x09749, 0x00b49, 0x11a93, 0x00a95, 0x0052d, 0x0caad, 0x00ab5,
+		0x005aa, 0x08ba5, 0x00da5, 0x00d4a, 0x07a95, 0x00c95, 0x0f52e, 0x00556,
+		0x00ab5, 0x0b5b2, 0x006d2, 0x00ea5, 0x09e4a, 0x0064a, 0x10c97, 0x00cab,
+		0x0055a, 0x0cad5, 0x00b69, 0x00752, 0x096a5, 0x00b25, 0x0064b, 0x07497,
+		0x004ab,
+	}
+
+	// 음력 연도의 1월 1일이 lunarEpoch 로부터 떨어진 날수
+	lunarYearStarts = buildLunarYearStarts()
+)
+
+// LunarDate 음력 날짜
+type LunarDate struct {
+	Year  int
+	Month int
+	Day   int
+	Leap  bool // 윤달
+}
+
+// SolarToLunar 양력 날짜를 음력 날짜로 바꿉니다. t 의 시간대에서의 날짜를 씁니다.
+// 음력 1900년 1월 1일 (1900년 1월 31일)부터 음력 2100년 12월 말까지 바꿀 수 있으며,
+// 범위를 벗어나면 ErrTimeRange 오류를 반환합니다.
+//
+//	SolarToLunar(time.Date(2026, 9, 25, 0, 0, 0, 0, time.Local)) // 음력 2026년 8월 15일
+func SolarToLunar(t time.Time) (LunarDate, error) {
+	y, m, d := t.Date()
+	days := int(time.Date(y, m, d, 0, 0, 0, 0, time.UTC).Sub(lunarEpoch).Hours() / 24)
+	if days < 0 || days >= lunarYearStarts[len(lunarYearStarts)-1] {
+		return LunarDate{}, &TimeError{Func: "SolarToLunar", Value: t.Format("2006-01-02"), Err: ErrTimeRange}
+	}
+
+	i := sort.Search(len(lunarYearStarts), func(i int) bool { return lunarYearStarts[i] > days }) - 1
+	year := lunarFirstYear + i
+	days -= lunarYearStarts[i]
+
+	leapMonth := LunarLeapMonth(year)
+	for n := 0; ; n++ {
+		month, leap := lunarMonthAt(n, leapMonth)
+		length := lunarMonthLength(year, n)
+		if days < length {
+			return LunarDate{Year: year, Month: month, Day: days + 1, Leap: leap}, nil
+		}
+		days -= length
+	}
+}
+
+// LunarToSolar 음력 날짜를 loc 시간대의 양력 날짜 0시로 바꿉니다.
+// 없는 날짜이거나 범위를 벗어나면 ErrTimeRange 오류를 반환합니다.
+//
+//	LunarToSolar(LunarDate{Year: 2026, Month: 1, Day: 1}, time.Local) // 2026년 2월 17일
+func LunarToSolar(d LunarDate, loc *time.Location) (time.Time, error) {
+	n, ok := d.monthIndex()
+	if !ok || d.Day < 1 || d.Day > lunarMonthLength(d.Year, n) {
+		return time.Time{}, &TimeError{Func: "LunarToSolar", Value: d.Format(LayoutLunarFull), Err: ErrTimeRange}
+	}
+
+	days := lunarYearStarts[d.Year-lunarFirstYear] + d.Day - 1
+	for i := 0; i < n; i++ {
+		days += lunarMonthLength(d.Year, i)
+	}
+	y, m, day := lunarEpoch.AddDate(0, 0, days).Date()
+	return time.Date(y, m, day, 0, 0, 0, 0, loc), nil
+}
+
+// LunarLeapMonth 음력 연도의 윤달을 반환합니다. 윤달이 없거나 범위를 벗어나면 0 을 반환합니다.
+//
+//	LunarLeapMonth(2025) // 6
+func LunarLeapMonth(year int) int {
+	if year < lunarFirstYear || year > lunarLastYear {
+		return 0
+	}
+	return int(lunarYearInfo[year-lunarFirstYear] >> 13 & 0xf)
+}
+
+// MonthDays 음력 날짜가 속한 달의 날수(29 또는 30)를 반환합니다. 없는 달이면 0 을 반환합니다.
+func (d LunarDate) MonthDays() int {
+	n, ok := d.monthIndex()
+	if !ok {
+		return 0
+	}
+	return lunarMonthLength(d.Year, n)
+}
+
+// String 음력 날짜를 LayoutLunar 로 씁니다. (음력 8월 15일, 음력 윤6월 1일)
+func (d LunarDate) String() string {
+	return d.Format(LayoutLunar)
+}
+
+// Format 음력 날짜를 레이아웃에 따라 씁니다. 모르는 토큰은 그대로 둡니다.
+//
+//	{Y}             연도 (2026)
+//	{L}             윤달이면 '윤'
+//	{M} {MK}        월 (8, 팔월)
+//	{D} {DK}        일 (15, 보름), {DK}는 초하루, 초열흘, 그믐처럼 고유어로 읽습니다.
+//	{E} {EEEE}      양력 날짜의 요일 (금, 금요일)
+//	{YG} {YGH} {YA} 연도의 간지와 띠 (병오, 丙午, 말)
+//	{MG} {MGH}      월건 (정유, 丁酉), 윤달은 앞 달의 월건을 씁니다.
+//	{DG} {DGH}      일진 (임인, 壬寅)
+//
+//	d.Format(LayoutLunarSpoken) // 음력 팔월 보름
+func (d LunarDate) Format(layout string) string {
+	return formatLayout(layout, func(token string) (string, bool) {
+		switch token {
+		case "Y":
+			return strconv.Itoa(d.Year), true
+		case "L":
+			if d.Leap {
+				return "윤", true
+			}
+			return "", true
+		case "M":
+			return strconv.Itoa(d.Month), true
+		case "MK":
+			if d.Month < 1 || d.Month > len(monthHanguls) {
+				return strconv.Itoa(d.Month) + "월", true
+			}
+			return monthHanguls[d.Month-1], true
+		case "D":
+			return strconv.Itoa(d.Day), true
+		case "DK":
+			lastDay := d.MonthDays()
+			if lastDay == 0 {
+				lastDay = daysInMonthCount
+			}
+			if s, err := DayOfMonth(d.Day, lastDay); err == nil {
+				return s, true
+			}
+			return strconv.Itoa(d.Day) + "일", true
+		}
+
+		t, err := LunarToSolar(d, time.UTC)
+		switch token {
+		case "E", "EEEE":
+			if err != nil {
+				return "", true
+			}
+			return Weekday(t.Weekday(), token == "EEEE"), true
+		case "DG", "DGH":
+			if err != nil {
+				return "", true
+			}
+		}
+		return ganjiToken(token, t, d, true)
+	})
+}
+
+// monthIndex 음력 날짜의 달이 그 해의 몇 번째 달(0부터)인지 반환합니다.
+func (d LunarDate) monthIndex() (int, bool) {
+	if d.Year < lunarFirstYear || d.Year > lunarLastYear || d.Month < 1 || d.Month > 12 {
+		return 0, false
+	}
+
+	leapMonth := LunarLeapMonth(d.Year)
+	switch {
+	case d.Leap && d.Month != leapMonth:
+		return 0, false
+	case d.Leap, leapMonth != 0 && d.Month > leapMonth:
+		return d.Month, true
+	}
+	return d.Month - 1, true
+}
+
+// lunarMonthAt 그 해의 n 번째 달(0부터)의 월과 윤달 여부를 반환합니다.
+func lunarMonthAt(n, leapMonth int) (int, bool) {
+	switch {
+	case leapMonth == 0 || n < leapMonth:
+		return n + 1, false
+	case n == leapMonth:
+		return leapMonth, true
+	}
+	return n, false
+}
+
+// lunarMonthLength 음력 연도의 n 번째 달(0부터)의 날수를 반환합니다.
+func lunarMonthLength(year, n int) int {
+	if lunarYearInfo[year-lunarFirstYear]>>n&1 == 1 {
+		return 30
+	}
+	return 29
+}
+
+// lunarYearMonths 음력 연도의 달 수를 반환합니다.
+func lunarYearMonths(year int) int {
+	if LunarLeapMonth(year) != 0 {
+		return 13
+	}
+	return 12
+}
+
+// buildLunarYearStarts 음력 연도마다 1월 1일까지의 날수를 계산합니다.
+// 마지막 값은 음력 2100년의 다음 해 1월 1일까지의 날수입니다.
+func buildLunarYearStarts() []int {
+	starts := make([]int, len(lunarYearInfo)+1)
+	for i := range lunarYearInfo {
+		days := 0
+		for n := 0; n < lunarYearMonths(lunarFirstYear+i); n++ {
+			days += lunarMonthLength(lunarFirstYear+i, n)
+		}
+		starts[i+1] = starts[i] + days
+	}
+	return starts
+}
@@ -0,0 +1,323 @@
+package gohangul
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+func BenchmarkSolarToLunar(b *testing.B) {
+	t := time.Date(2026, 9, 25, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < b.N; i++ {
+		SolarToLunar(t)
+	}
+}
+
+func BenchmarkLunarToSolar(b *testing.B) {
+	d := LunarDate{Year: 2026, Month: 8, Day: 15}
+	for i := 0; i < b.N; i++ {
+		LunarToSolar(d, time.UTC)
+	}
+}
+
+var lunarTests = []struct {
+	solar string
+	lunar LunarDate
+}{
+	{"1900-01-31", LunarDate{Year: 1900, Month: 1, Day: 1}},
+	{"1900-09-24", LunarDate{Year: 1900, Month: 8, Day: 1, Leap: true}},
+	{"1997-02-08", LunarDate{Year: 1997, Month: 1, Day: 1}},
+	{"2012-04-21", LunarDate{Year: 2012, Month: 3, Day: 1, Leap: true}},
+	{"2020-06-20", LunarDate{Year: 2020, Month: 4, Day: 29, Leap: true}},
+	{"2023-09-29", LunarDate{Year: 2023, Month: 8, Day: 15}},
+	{"2024-02-09", LunarDate{Year: 2023, Month: 12, Day: 30}},
+	{"2024-02-10", LunarDate{Year: 2024, Month: 1, Day: 1}},
+	{"2025-07-25", LunarDate{Year: 2025, Month: 6, Day: 1, Leap: true}},
+	{"2025-10-06", LunarDate{Year: 2025, Month: 8, Day: 15}},
+	{"2026-02-17", LunarDate{Year: 2026, Month: 1, Day: 1}},
+	{"2026-05-24", LunarDate{Year: 2026, Month: 4, Day: 8}},
+	{"2026-09-25", LunarDate{Year: 2026, Month: 8, Day: 15}},
+	{"2027-02-07", LunarDate{Year: 2027, Month: 1, Day: 1}},
+	{"2101-01-28", LunarDate{Year: 2100, Month: 12, Day: 29}},
+}
+
+func TestSolarToLunar(t *testing.T) {
+	for _, test := range lunarTests {
+		solar, _ := time.Parse("2006-01-02", test.solar)
+		result, err := SolarToLunar(solar)
+		if err != nil || result != test.lunar {
+			t.Errorf("SolarToLunar(%s) = %+v, %v; want %+v", test.solar, result, err, test.lunar)
+		}
+	}
+
+	for _, value := range []string{"1900-01-30", "2101-01-29"} {
+		solar, _ := time.Parse("2006-01-02", value)
+		if _, err := SolarToLunar(solar); !errors.Is(err, ErrTimeRange) {
+			t.Errorf("SolarToLunar(%s) error = %v; want %v", value, err, ErrTimeRange)
+		}
+	}
+}
+
+func TestLunarToSolar(t *testing.T) {
+	for _, test := range lunarTests {
+		result, err := LunarToSolar(test.lunar, time.UTC)
+		if err != nil || result.Format("2006-01-02") != test.solar {
+			t.Errorf("LunarToSolar(%+v) = %v, %v; want %s", test.lunar, result, err, test.solar)
+		}
+	}
+
+	for _, d := range []LunarDate{
+		{Year: 2024, Month: 1, Day: 30},
+		{Year: 2026, Month: 4, Day: 1, Leap: true},
+		{Year: 2026, Month: 13, Day: 1},
+		{Year: 1899, Month: 12, Day: 1},
+		{Year: 2101, Month: 1, Day: 1},
+	} {
+		if _, err := LunarToSolar(d, time.UTC); !errors.Is(err, ErrTimeRange) {
+			t.Errorf("LunarToSolar(%+v) error = %v; want %v", d, err, ErrTimeRange)
+		}
+	}
+}
+
+func TestLunarLeapMonth(t *testing.T) {
+	tests := []struct {
+		year     int
+		expected int
+	}{
+		{2012, 3},
+		{2020, 4},
+		{2023, 2},
+		{2025, 6},
+		{2026, 0},
+		{2028, 5},
+		{1899, 0},
+	}
+
+	for _, test := range tests {
+		if result := LunarLeapMonth(test.year); result != test.expected {
+			t.Errorf("LunarLeapMonth(%d) = %d; want %d", test.year, result, test.expected)
+		}
+	}
+}
+
+func TestLunarDateFormat(t *testing.T) {
+	chuseok := LunarDate{Year: 2026, Month: 8, Day: 15}
+	tests := []struct {
+		date     LunarDate
+		layout   string
+		expected string
+	}{
+		{chuseok, LayoutLunar, "음력 8월 15일"},
+		{chuseok, LayoutLunarSpoken, "음력 팔월 보름"},
+		{chuseok, LayoutLunarFull, "음력 2026년 8월 15일"},
+		{chuseok, "{M}월 {D}일 ({E})", "8월 15일 (금)"},
+		{LunarDate{Year: 2025, Month: 6, Day: 1, Leap: true}, LayoutLunar, "음력 윤6월 1일"},
+		{LunarDate{Year: 2025, Month: 6, Day: 1, Leap: true}, LayoutLunarSpoken, "음력 윤유월 초하루"},
+		{LunarDate{Year: 2023, Month: 12, Day: 30}, "{MK} {DK} {EEEE}", "십이월 그믐 금요일"},
+	}
+
+	for _, test := range tests {
+		if result := test.date.Format(test.layout); result != test.expected {
+			t.Errorf("%+v.Format(%q) = %q; want %q", test.date, test.layout, result, test.expected)
+		}
+	}
+
+	if result := chuseok.String(); result != "음력 8월 15일" {
+		t.Errorf("String() = %q; want %q", result, "음력 8월 15일")
+	}
+}
+
+// TestLunarYearInfo 내장된 달 정보가 합삭과 중기로 계산한 음력과 같은지 확인합니다.
+func TestLunarYearInfo(t *testing.T) {
+	months := computeLunarMonths(lunarFirstYear-1, lunarLastYear+1)
+
+	info := make(map[int]uint32)
+	n := make(map[int]int)
+	for i, m := range months[:len(months)-1] {
+		if m.year < lunarFirstYear || m.year > lunarLastYear {
+			continue
+		}
+		if months[i+1].start.Sub(m.start) == 30*24*time.Hour {
+			info[m.year] |= 1 << n[m.year]
+		}
+		if m.leap {
+			info[m.year] |= uint32(m.month) << 13
+		}
+		n[m.year]++
+
+		if m.year == lunarFirstYear && n[m.year] == 1 && !m.start.Equal(lunarEpoch) {
+			t.Errorf("lunarEpoch = %v; want %v", lunarEpoch, m.start)
+		}
+	}
+
+	for year := lunarFirstYear; year <= lunarLastYear; year++ {
+		if lunarYearInfo[year-lunarFirstYear] != info[year] {
+			t.Errorf("lunarYearInfo[%d] = %#05x; want %#05x", year, lunarYearInfo[year-lunarFirstYear], info[year])
+		}
+	}
+}
+
+// lunarMonth 음력 한 달
+type lunarMonth struct {
+	start time.Time // 초하루의 양력 날짜 (UTC 0시)
+	year  int
+	month int
+	leap  bool
+}
+
+// 음력 표(lunarYearInfo)를 다시 계산하는 데에만 쓰는 삭의 계산 (Meeus 49장)
+
+// synodicMonth 삭망월의 평균 길이 (일)
+const synodicMonth = 29.530588861
+
+var (
+	// 삭의 보정항 (계수, E 의 차수, M, M', F, Ω 의 배수)
+	newMoonTerms = [...]struct {
+		coef     float64
+		e        int
+		m, mp, f float64
+		omega    float64
+	}{
+		{-0.40720, 0, 0, 1, 0, 0},
+		{0.17241, 1, 1, 0, 0, 0},
+		{0.01608, 0, 0, 2, 0, 0},
+		{0.01039, 0, 0, 0, 2, 0},
+		{0.00739, 1, -1, 1, 0, 0},
+		{-0.00514, 1, 1, 1, 0, 0},
+		{0.00208, 2, 2, 0, 0, 0},
+		{-0.00111, 0, 0, 1, -2, 0},
+		{-0.00057, 0, 0, 1, 2, 0},
+		{0.00056, 1, 1, 2, 0, 0},
+		{-0.00042, 0, 0, 3, 0, 0},
+		{0.00042, 1, 1, 0, 2, 0},
+		{0.00038, 1, 1, 0, -2, 0},
+		{-0.00024, 1, -1, 2, 0, 0},
+		{-0.00017, 0, 0, 0, 0, 1},
+		{-0.00007, 0, 2, 1, 0, 0},
+		{0.00004, 0, 0, 2, -2, 0},
+		{0.00004, 0, 3, 0, 0, 0},
+		{0.00003, 0, 1, 1, -2, 0},
+		{0.00003, 0, 0, 2, 2, 0},
+		{-0.00003, 0, 1, 1, 2, 0},
+		{0.00003, 0, -1, 1, 2, 0},
+		{-0.00002, 0, -1, 1, -2, 0},
+		{-0.00002, 0, 1, 3, 0, 0},
+		{0.00002, 0, 0, 4, 0, 0},
+	}
+
+	// 삭의 추가 보정항 (계수, 상수, k 의 계수)
+	newMoonPlanetaryTerms = [...][3]float64{
+		{0.000325, 299.77, 0.107408},
+		{0.000165, 251.88, 0.016321},
+		{0.000164, 251.83, 26.651886},
+		{0.000126, 349.42, 36.412478},
+		{0.000110, 84.66, 18.206239},
+		{0.000062, 141.74, 53.303771},
+		{0.000060, 207.14, 2.453732},
+		{0.000056, 154.84, 7.306860},
+		{0.000047, 34.52, 27.261239},
+		{0.000042, 207.19, 0.121824},
+		{0.000040, 291.34, 1.844379},
+		{0.000037, 161.72, 24.198154},
+		{0.000035, 239.56, 25.513099},
+		{0.000023, 331.55, 3.592518},
+	}
+)
+
+// newMoonJDE k 번째 삭의 율리우스일 (TT)을 반환합니다. k = 0 은 2000년 1월 6일의 삭입니다.
+func newMoonJDE(k float64) float64 {
+	t := k / 1236.85
+	t2, t3, t4 := t*t, t*t*t, t*t*t*t
+
+	jde := 2451550.09766 + synodicMonth*k + 0.00015437*t2 - 0.000000150*t3 + 0.00000000073*t4
+	e := 1 - 0.002516*t - 0.0000074*t2
+	m := (2.5534 + 29.10535670*k - 0.0000014*t2 - 0.00000011*t3) * degreesToRadian
+	mp := (201.5643 + 385.81693528*k + 0.0107582*t2 + 0.00001238*t3 - 0.000000058*t4) * degreesToRadian
+	f := (160.7108 + 390.67050284*k - 0.0016118*t2 - 0.00000227*t3 + 0.000000011*t4) * degreesToRadian
+	omega := (124.7746 - 1.56375588*k + 0.0020672*t2 + 0.00000215*t3) * degreesToRadian
+
+	for _, term := range newMoonTerms {
+		v := term.coef * math.Sin(term.m*m+term.mp*mp+term.f*f+term.omega*omega)
+		for i := 0; i < term.e; i++ {
+			v *= e
+		}
+		jde += v
+	}
+	for i, term := range newMoonPlanetaryTerms {
+		a := term[1] + term[2]*k
+		if i == 0 {
+			a -= 0.009173 * t2
+		}
+		jde += term[0] * math.Sin(a*degreesToRadian)
+	}
+	return jde
+}
+
+// koreaDate 율리우스일 (TT)의 한국 날짜를 UTC 0시의 시각으로 반환합니다.
+func koreaDate(jde float64) time.Time {
+	y, m, d := koreaTime(jdeToTime(jde)).Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// computeLunarMonths 동지가 든 달을 11월로 삼고, 중기가 없는 첫 달을 윤달로 두어
+// 양력 fromYear 년의 동지부터 toYear 년의 동지 전까지의 음력 달을 계산합니다.
+// 마지막 달의 길이를 알 수 있도록 다음 달의 초하루를 함께 반환합니다.
+func computeLunarMonths(fromYear, toYear int) []lunarMonth {
+	var months []lunarMonth
+
+	for year := fromYear; year < toYear; year++ {
+		k0 := newMoonIndexBefore(koreaDate(solarTermJDE(year, 270)))
+		k1 := newMoonIndexBefore(koreaDate(solarTermJDE(year+1, 270)))
+
+		var principal []time.Time
+		for _, y := range []int{year, year + 1} {
+			for deg := 0; deg < 360; deg += 30 {
+				principal = append(principal, koreaDate(solarTermJDE(y, float64(deg))))
+			}
+		}
+		hasPrincipal := func(start, end time.Time) bool {
+			for _, d := range principal {
+				if !d.Before(start) && d.Before(end) {
+					return true
+				}
+			}
+			return false
+		}
+
+		leapFound := k1-k0 == 12
+		month := 11
+		for k := k0; k < k1; k++ {
+			start := koreaDate(newMoonJDE(float64(k)))
+			end := koreaDate(newMoonJDE(float64(k + 1)))
+
+			m := lunarMonth{start: start, year: year + 1, month: month}
+			if !leapFound && !hasPrincipal(start, end) {
+				leapFound = true
+				m.leap = true
+				m.month = months[len(months)-1].month
+			} else {
+				month = month%12 + 1
+			}
+			if m.month >= 11 {
+				m.year = year
+			}
+			months = append(months, m)
+		}
+	}
+
+	next := koreaDate(newMoonJDE(float64(newMoonIndexBefore(koreaDate(solarTermJDE(toYear, 270))))))
+	return append(months, lunarMonth{start: next})
+}
+
+// newMoonIndexBefore 한국 날짜로 date 이전이거나 같은 날인 마지막 삭의 번호를 반환합니다.
+func newMoonIndexBefore(date time.Time) int {
+	k := int((julianDay(date) - 2451550.09766) / synodicMonth)
+	for koreaDate(newMoonJDE(float64(k))).After(date) {
+		k--
+	}
+	for !koreaDate(newMoonJDE(float64(k + 1))).After(date) {
+		k++
+	}
+	return k
+}
@@ -0,0 +1,341 @@
+package gohangul
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+var (
+	// 한자어 수사와 어울리는 단위 명사
+	// 고유어 수사와 어울리는 단위 명사는 nativeCounters 에 있습니다.
+	sinoCounters = map[string]bool{
+		"분":    true,
+		"원":    true,
+		"년":    true,
+		"층":    true,
+		"월":    true,
+		"일":    true,
+		"초":    true,
+		"세":    true,
+		"주":    true,
+		"호":    true,
+		"회":    true,
+		"도":    true,
+		"점":    true,
+		"쪽":    true,
+		"등":    true,
+		"위":    true,
+		"차":    true,
+		"박":    true,
+		"개월":   true,
+		"주일":   true,
+		"번지":   true,
+		"인분":   true,
+		"학년":   true,
+		"학기":   true,
+		"페이지":  true,
+		"퍼센트":  true,
+		"미터":   true,
+		"그램":   true,
+		"리터":   true,
+		"킬로미터": true,
+		"킬로그램": true,
+		"센티미터": true,
+	}
+
+	// 숫자 뒤의 단위 명사, 긴 것부터 정렬
+	counterWords = buildCounterWords()
+
+	// 요일의 약자 -> time.Weekday
+	weekdayAbbrs = buildWeekdayAbbrs()
+
+	// 기본 규칙으로 바꾸는 Normalizer
+	defaultNormalizer = NewNormalizer(DefaultNormalizeRules()...)
+)
+
+// NormalizeRule 문장에서 찾아 읽는 대로 바꿀 규칙
+type NormalizeRule struct {
+	Name    string                               // 규칙의 이름
+	Pattern *regexp.Regexp                       // 찾을 표현
+	Replace func(groups []string) (string, bool) // 찾은 표현과 하위 표현을 받아 읽기를 반환, false 이면 바꾸지 않습니다.
+}
+
+// Normalizer 문장의 숫자와 기호를 읽는 대로 바꿉니다.
+// 같은 위치에서 여러 규칙이 맞으면 앞의 규칙을 적용합니다.
+type Normalizer struct {
+	Rules []NormalizeRule
+}
+
+// NewNormalizer 규칙을 받아 Normalizer 를 만듭니다.
+// 기본 규칙에 규칙을 더하려면 DefaultNormalizeRules 를 함께 넘깁니다.
+func NewNormalizer(rules ...NormalizeRule) *Normalizer {
+	return &Normalizer{Rules: rules}
+}
+
+// NormalizeText 기본 규칙으로 문장의 숫자, 단위, 날짜, 시각, 전화번호, 금액을 읽는 대로 바꿉니다.
+//
+//	NormalizeText("사과 3개") // 사과 세 개
+//	NormalizeText("3층")     // 삼 층
+//	NormalizeText("10:30")   // 열 시 삼십 분
+func NormalizeText(text string) string {
+	return defaultNormalizer.Normalize(text)
+}
+
+// Normalize 규칙에 따라 문장을 읽는 대로 바꿉니다.
+// 규칙마다 문장을 한 번씩 찾고, 가장 앞에서 찾은 표현부터 바꿉니다.
+// 규칙이 바꾸지 않으면 같은 위치에서 찾은 다음 규칙을 적용하고, 모든 규칙이 바꾸지 않으면 앞의 규칙이 찾은 표현을 그대로 둡니다.
+func (n *Normalizer) Normalize(text string) string {
+	matches := make([][][]int, len(n.Rules))
+	for i, rule := range n.Rules {
+		matches[i] = rule.Pattern.FindAllStringSubmatchIndex(text, -1)
+	}
+
+	var sb strings.Builder
+	sb.Grow(len(text))
+	pos := 0
+
+	for {
+		// 이미 바꾼 표현 안에서 찾은 표현은 버립니다.
+		start := -1
+		for i := range matches {
+			for len(matches[i]) > 0 && matches[i][0][0] < pos {
+				matches[i] = matches[i][1:]
+			}
+			if len(matches[i]) > 0 && (start < 0 || matches[i][0][0] < start) {
+				start = matches[i][0][0]
+			}
+		}
+		if start < 0 {
+			break
+		}
+
+		end := -1
+		replaced, ok := "", false
+		for i := range matches {
+			if len(matches[i]) == 0 || matches[i][0][0] != start {
+				continue
+			}
+			loc := matches[i][0]
+			if end < 0 {
+				end = loc[1]
+			}
+			if loc[1] == start {
+				continue
+			}
+			if replaced, ok = n.Rules[i].Replace(submatches(text, loc)); ok {
+				end = loc[1]
+				break
+			}
+		}
+
+		sb.WriteString(text[pos:start])
+		switch {
+		case ok:
+			sb.WriteString(replaced)
+		case end == start:
+			// 빈 표현만 찾았으면 한 글자를 넘깁니다.
+			_, size := utf8.DecodeRuneInString(text[start:])
+			end = start + size
+			sb.WriteString(text[start:end])
+		default:
+			sb.WriteString(text[start:end])
+		}
+		pos = end
+	}
+
+	sb.WriteString(text[pos:])
+	return sb.String()
+}
+
+// submatches 찾은 위치로 표현과 하위 표현을 잘라 반환합니다.
+func submatches(text string, loc []int) []string {
+	groups := make([]string, len(loc)/2)
+	for i := range groups {
+		if loc[2*i] >= 0 {
+			groups[i] = text[loc[2*i]:loc[2*i+1]]
+		}
+	}
+	return groups
+}
+
+// 숫자의 표현, 쉼표는 세 자리마다 찍은 경우만 숫자로 봅니다. (12,000)
+const numberPattern = `(?:\d{1,3}(?:,\d{3})+\b|\d+)`
+
+// DefaultNormalizeRules 기본 규칙을 반환합니다.
+// 전화번호, 날짜, 시각, 금액, 백분율과 분수, 기간, 단위 명사가 붙은 숫자, 숫자 순서로 적용합니다.
+func DefaultNormalizeRules() []NormalizeRule {
+	return []NormalizeRule{
+		{
+			Name:    "phone",
+			Pattern: regexp.MustCompile(`\b0\d{1,2}-\d{3,4}-\d{4}\b`),
+			Replace: readPhoneNumber,
+		},
+		{
+			Name:    "date",
+			Pattern: regexp.MustCompile(`\b(\d{4})[./-](\d{1,2})[./-](\d{1,2})\.?(?:\s*\(([일월화수목금토])\))?`),
+			Replace: readDate,
+		},
+		{
+			Name:    "time",
+			Pattern: regexp.MustCompile(`\b(\d{1,2}):(\d{2})(?::(\d{2}))?\b`),
+			Replace: readTime,
+		},
+		{
+			Name:    "won",
+			Pattern: regexp.MustCompile(`₩\s?(` + numberPattern + `)`),
+			Replace: func(groups []string) (string, bool) {
+				return readNumber(groups[1]) + " 원", true
+			},
+		},
+		{
+			Name:    "expression",
+			Pattern: regexp.MustCompile(numberPattern + `(?:\.\d+)?%|\b\d+/\d+\b`),
+			Replace: func(groups []string) (string, bool) {
+				result, err := ExpressionToHangul(groups[0], ExpressionOptions{Number: NumberOptions{OmitOne: true}})
+				return result, err == nil
+			},
+		},
+		{
+			Name:    "days",
+			Pattern: regexp.MustCompile(`\b(\d{1,2})일(\s?)(간|동안)`),
+			Replace: func(groups []string) (string, bool) {
+				day, _ := strconv.Atoi(groups[1])
+				if day < 1 || day > len(daysHanguls) {
+					return "", false
+				}
+				return Days(day) + groups[2] + groups[3], true
+			},
+		},
+		{
+			Name:    "counter",
+			Pattern: regexp.MustCompile(`(` + numberPattern + `(?:\.\d+)?)(\s?)([가-힣]+)`),
+			Replace: readCounter,
+		},
+		{
+			Name:    "number",
+			Pattern: regexp.MustCompile(numberPattern + `(?:\.\d+)*`),
+			Replace: func(groups []string) (string, bool) {
+				// 점이 여럿이면 숫자가 아닙니다. (2024.13.45)
+				if strings.Count(groups[0], ".") > 1 {
+					return "", false
+				}
+				return readNumber(groups[0]), true
+			},
+		},
+	}
+}
+
+// readPhoneNumber 전화번호를 한 자리씩 읽습니다. (010-1234-5678 -> 공일공 일이삼사 오육칠팔)
+func readPhoneNumber(groups []string) (string, bool) {
+	var sb strings.Builder
+	for _, ch := range groups[0] {
+		switch {
+		case ch == '-':
+			sb.WriteByte(' ')
+		case ch == '0':
+			sb.WriteString("공")
+		default:
+			sb.WriteString(numberHanguls[ch-'0'])
+		}
+	}
+	return sb.String(), true
+}
+
+// readDate 날짜를 읽습니다. (2024.6.10(월) -> 이천이십사 년 유월 십 일 월요일)
+// 날짜가 아닌 값은 숫자로 나누어 읽지 않고 그대로 둡니다. (2024.13.45)
+func readDate(groups []string) (string, bool) {
+	month, _ := strconv.Atoi(groups[2])
+	day, _ := strconv.Atoi(groups[3])
+	if month < 1 || month > 12 || day < 1 || day > 31 {
+		return groups[0], true
+	}
+
+	result := readNumber(groups[1]) + " 년 " + monthHanguls[month-1] + " " + spokenNumberHangul(day) + " 일"
+	if groups[4] != "" {
+		result += " " + Weekday(weekdayAbbrs[groups[4]], true)
+	}
+	return result, true
+}
+
+// readTime 시각을 읽습니다. 시는 고유어 수사, 분과 초는 한자어 수사로 읽습니다. (10:30 -> 열 시 삼십 분)
+func readTime(groups []string) (string, bool) {
+	hour, _ := strconv.Atoi(groups[1])
+	minute, _ := strconv.Atoi(groups[2])
+	second := 0
+	if groups[3] != "" {
+		second, _ = strconv.Atoi(groups[3])
+	}
+	if hour > 24 || minute > 59 || second > 59 {
+		return "", false
+	}
+
+	result := CountToHangul(hour, "시")
+	if minute > 0 {
+		result += " " + CountToHangul(minute, "분")
+	}
+	if second > 0 {
+		result += " " + CountToHangul(second, "초")
+	}
+	return result, true
+}
+
+// readCounter 단위 명사가 붙은 숫자를 단위 명사에 맞는 수사로 읽습니다. (3개 -> 세 개, 3층 -> 삼 층)
+// 단위 명사를 모르면 한자어 수사로 읽고 띄어쓰기는 그대로 둡니다.
+func readCounter(groups []string) (string, bool) {
+	number, sep, word := groups[1], groups[2], groups[3]
+
+	for _, counter := range counterWords {
+		rest, ok := strings.CutPrefix(word, counter)
+		if !ok {
+			continue
+		}
+
+		n, err := strconv.Atoi(strings.ReplaceAll(number, ",", ""))
+		switch {
+		case err != nil:
+			return readNumber(number) + " " + word, true
+		case counter == "월" && n >= 1 && n <= 12:
+			return monthHanguls[n-1] + rest, true
+		default:
+			return CountToHangul(n, counter) + rest, true
+		}
+	}
+	return readNumber(number) + sep + word, true
+}
+
+// readNumber 숫자를 말할 때처럼 한자어 수사로 읽습니다.
+func readNumber(number string) string {
+	return NumberToHangulWith(number, NumberOptions{OmitOne: true})
+}
+
+// buildCounterWords 단위 명사를 긴 것부터 정렬해 반환합니다.
+func buildCounterWords() []string {
+	var words []string
+	for counter := range nativeCounters {
+		words = append(words, counter)
+	}
+	for counter := range sinoCounters {
+		words = append(words, counter)
+	}
+
+	sort.Slice(words, func(i, j int) bool {
+		if len(words[i]) != len(words[j]) {
+			return len(words[i]) > len(words[j])
+		}
+		return words[i] < words[j]
+	})
+	return words
+}
+
+// buildWeekdayAbbrs 요일의 약자 표를 만듭니다.
+func buildWeekdayAbbrs() map[string]time.Weekday {
+	abbrs := make(map[string]time.Weekday, len(weekdayHanguls))
+	for i, v := range weekdayHanguls {
+		abbrs[v] = time.Weekday(i)
+	}
+	return abbrs
+}
@@ -0,0 +1,107 @@
+package gohangul
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+)
+
+func BenchmarkNormalizeText(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		NormalizeText("2024.6.10(월) 10:30에 사과 3개를 12,000원에 샀다")
+	}
+}
+
+func TestNormalizeText(t *testing.T) {
+	tests := []struct {
+		text     string
+		expected string
+	}{
+		{"", ""},
+		{"숫자 없음", "숫자 없음"},
+		{"사과 3개", "사과 세 개"},
+		{"사과 3개를 먹었다", "사과 세 개를 먹었다"},
+		{"3층", "삼 층"},
+		{"10:30", "열 시 삼십 분"},
+		{"오후 3:05:09", "오후 세 시 오 분 구 초"},
+		{"12:00에 만나요", "열두 시에 만나요"},
+		{"학생 20명", "학생 스무 명"},
+		{"20살", "스무 살"},
+		{"3시간 30분", "세 시간 삼십 분"},
+		{"3개월", "삼 개월"},
+		{"2024년 6월 10일", "이천이십사 년 유월 십 일"},
+		{"10월", "시월"},
+		{"2024.6.10(월)", "이천이십사 년 유월 십 일 월요일"},
+		{"2024-10-03", "이천이십사 년 시월 삼 일"},
+		{"3일 동안", "사흘 동안"},
+		{"15일간", "보름간"},
+		{"010-1234-5678", "공일공 일이삼사 오육칠팔"},
+		{"02-123-4567로 전화", "공이 일이삼 사오육칠로 전화"},
+		{"₩1,230,000", "백이십삼만 원"},
+		{"12,000원", "만이천 원"},
+		{"50% 할인", "오십 퍼센트 할인"},
+		{"2/3 정도", "삼분의 이 정도"},
+		{"1.5배", "일점오배"},
+		{"1.5개", "일점오 개"},
+		{"번호는 42", "번호는 사십이"},
+		{"25:30", "이십오:삼십"},
+		{"99:99", "구십구:구십구"},
+		{"2024.13.45", "2024.13.45"},
+		{"2024-02-32", "2024-02-32"},
+		{"31일간", "삼십일 일간"},
+		{"32일 동안", "삼십이 일 동안"},
+		{"1,2,3", "일,이,삼"},
+		{"10,000,000원", "천만 원"},
+	}
+
+	for _, test := range tests {
+		result := NormalizeText(test.text)
+		if result != test.expected {
+			t.Errorf("NormalizeText(%q) = %q; want %q", test.text, result, test.expected)
+		}
+	}
+}
+
+func TestNormalizer_Normalize(t *testing.T) {
+	emoticon := NormalizeRule{
+		Name:    "emoticon",
+		Pattern: regexp.MustCompile(`\^\^`),
+		Replace: func(groups []string) (string, bool) {
+			return "웃음", true
+		},
+	}
+	version := NormalizeRule{
+		Name:    "version",
+		Pattern: regexp.MustCompile(`v(\d+)`),
+		Replace: func(groups []string) (string, bool) {
+			return "버전 " + strings.Join(strings.Split(groups[1], ""), " "), true
+		},
+	}
+
+	n := NewNormalizer(append([]NormalizeRule{emoticon, version}, DefaultNormalizeRules()...)...)
+	result := n.Normalize("v12 출시 ^^ 사과 2개")
+	want := "버전 1 2 출시 웃음 사과 두 개"
+	if result != want {
+		t.Errorf("Normalize() = %q; want %q", result, want)
+	}
+
+	n = NewNormalizer(emoticon)
+	result = n.Normalize("사과 2개 ^^")
+	want = "사과 2개 웃음"
+	if result != want {
+		t.Errorf("Normalize() = %q; want %q", result, want)
+	}
+}
+
+func TestNormalizeTextLongInput(t *testing.T) {
+	text := strings.Repeat("사과 3개와 99:99 그리고 1,2,3 ", 5000)
+	start := time.Now()
+	result := NormalizeText(text)
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("NormalizeText(%q...) took %v", text[:20], elapsed)
+	}
+	if want := strings.Repeat("사과 세 개와 구십구:구십구 그리고 일,이,삼 ", 5000); result != want {
+		t.Errorf("NormalizeText(%q...) = %q...", text[:20], result[:40])
+	}
+}
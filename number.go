@@ -0,0 +1,658 @@
+package gohangul
+
+import (
+	"errors"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// numberPrec HangulToNumber 가 반환하는 실수의 정밀도 (무량대수와 소수 자리를 담을 수 있는 크기)
+const numberPrec = 256
+
+var (
+	// ErrNumberSyntax 한글 숫자의 형식이 올바르지 않습니다.
+	ErrNumberSyntax = errors.New("invalid syntax")
+	// ErrNumberFraction 정수로 읽어야 하는 한글 숫자에 소수 부분이 있습니다.
+	ErrNumberFraction = errors.New("unexpected fraction")
+	// ErrNumberRange 숫자가 무량대수보다 크거나 유한하지 않습니다.
+	ErrNumberRange = errors.New("value out of range")
+)
+
+// NumberStyle 숫자를 한글로 쓰는 방식
+type NumberStyle int
+
+const (
+	NumberFull   NumberStyle = iota // 일억이천삼백사십오만
+	NumberSpaced                    // 일억 이천삼백사십오만
+	NumberMixed                     // 1억 2,345만, 소수 부분은 0이 아닌 마지막 자리에 붙입니다. (1.00005만)
+)
+
+// NumberOptions 숫자를 한글로 변환할 때의 설정
+type NumberOptions struct {
+	Style   NumberStyle // 쓰는 방식
+	OmitOne bool        // '일십', '일백', '일천', '일만'의 '일'을 빼고 씁니다. (십, 백, 천, 만)
+}
+
+// NumberError 한글 숫자를 읽지 못했을 때의 오류
+type NumberError struct {
+	Func string // 오류가 난 함수 (HangulToNumber, HangulToInt)
+	Num  string // 입력
+	Err  error  // 오류의 원인
+}
+
+func (e *NumberError) Error() string {
+	return "gohangul." + e.Func + ": parsing " + strconv.Quote(e.Num) + ": " + e.Err.Error()
+}
+
+func (e *NumberError) Unwrap() error {
+	return e.Err
+}
+
+// numberTokenKind 한글 숫자를 이루는 낱말의 종류
+type numberTokenKind int
+
+const (
+	numberDigit      numberTokenKind = iota // 일, 이, 삼, 하나, 둘, 3, 15
+	numberSmallUnit                         // 십, 백, 천
+	numberLargeUnit                         // 만, 억, 조 …
+	numberNativeTens                        // 열, 스물, 서른 …
+)
+
+// numberToken 한글 숫자를 이루는 낱말
+type numberToken struct {
+	text  string
+	kind  numberTokenKind
+	value int  // 숫자의 값, 또는 단위의 10의 거듭제곱 지수
+	sino  bool // 이어 써서 자릿수를 읽을 수 있는 한자어 숫자인지 (일구구 -> 199)
+}
+
+var (
+	// 한글 숫자의 낱말, 긴 낱말부터 정렬
+	// 같은 길이라면 숫자를 단위보다 먼저 시도합니다. (구: 9, 溝)
+	numberTokens = buildNumberTokens()
+
+	// 소수 부분의 숫자
+	fractionDigits = map[string]int{
+		"영": 0,
+		"공": 0,
+		"일": 1,
+		"이": 2,
+		"삼": 3,
+		"사": 4,
+		"오": 5,
+		"육": 6,
+		"륙": 6,
+		"칠": 7,
+		"팔": 8,
+		"구": 9,
+	}
+)
+
+// NumberToHangulWith 숫자를 설정에 따라 한글로 변환합니다.
+// 숫자와 '.', 맨 앞의 '-' 외의 문자는 무시하고, 무량대수를 넘는 숫자는 빈 문자열을 반환합니다.
+//
+//	NumberToHangulWith("123450000", NumberOptions{Style: NumberMixed})  // 1억 2,345만
+//	NumberToHangulWith("123450000", NumberOptions{Style: NumberSpaced}) // 일억 이천삼백사십오만
+func NumberToHangulWith(number string, opts NumberOptions) string {
+	number = strings.TrimSpace(number)
+	negative := strings.HasPrefix(number, "-")
+
+	var sb strings.Builder
+	sb.Grow(len(number))
+
+	for _, ch := range number {
+		if (ch >= '0' && ch <= '9') || ch == '.' {
+			sb.WriteRune(ch)
+		}
+	}
+	if sb.Len() == 0 {
+		return ""
+	}
+
+	fields := strings.Split(sb.String(), ".")
+	var frac string
+	if len(fields) > 1 {
+		frac = fields[1]
+	}
+	return formatNumber(negative, fields[0], frac, opts)
+}
+
+// Int64ToHangul 정수를 설정에 따라 한글로 변환합니다.
+func Int64ToHangul(number int64, opts NumberOptions) string {
+	return NumberToHangulWith(strconv.FormatInt(number, 10), opts)
+}
+
+// Uint64ToHangul 부호 없는 정수를 설정에 따라 한글로 변환합니다.
+func Uint64ToHangul(number uint64, opts NumberOptions) string {
+	return NumberToHangulWith(strconv.FormatUint(number, 10), opts)
+}
+
+// BigIntToHangul 큰 정수를 설정에 따라 한글로 변환합니다.
+// 무량대수를 넘는 숫자는 ErrNumberRange 오류를 반환합니다.
+func BigIntToHangul(number *big.Int, opts NumberOptions) (string, error) {
+	str := number.String()
+	result := NumberToHangulWith(str, opts)
+	if result == "" {
+		return "", &NumberError{Func: "BigIntToHangul", Num: str, Err: ErrNumberRange}
+	}
+	return result, nil
+}
+
+// BigFloatToHangul 큰 실수를 설정에 따라 한글로 변환합니다.
+// 소수 부분은 정확히 나타낼 수 있는 자리까지 씁니다.
+// 무량대수를 넘거나 무한대인 숫자는 ErrNumberRange 오류를 반환합니다.
+func BigFloatToHangul(number *big.Float, opts NumberOptions) (string, error) {
+	str := number.Text('f', -1)
+	if number.IsInf() {
+		return "", &NumberError{Func: "BigFloatToHangul", Num: str, Err: ErrNumberRange}
+	}
+
+	result := NumberToHangulWith(str, opts)
+	if result == "" {
+		return "", &NumberError{Func: "BigFloatToHangul", Num: str, Err: ErrNumberRange}
+	}
+	return result, nil
+}
+
+// formatNumber 정수 부분과 소수 부분의 숫자를 한글로 씁니다.
+func formatNumber(negative bool, intPart, fracPart string, opts NumberOptions) string {
+	intPart = strings.TrimLeft(intPart, "0")
+	if intPart == "" {
+		intPart = "0"
+	}
+
+	numGroups := (len(intPart) + len(digitsHangul) - 1) / len(digitsHangul)
+	if numGroups > len(digitsHangul2) {
+		return ""
+	}
+	intPart = strings.Repeat("0", numGroups*len(digitsHangul)-len(intPart)) + intPart
+
+	var parts []string
+	for i := 0; i < numGroups; i++ {
+		group := intPart[i*len(digitsHangul) : (i+1)*len(digitsHangul)]
+		unit := numGroups - i - 1
+		if strings.Trim(group, "0") == "" {
+			continue
+		}
+
+		if opts.Style == NumberMixed {
+			// 아래 자리가 모두 0이면 소수 부분을 이 자리에 붙입니다. (10000.5 -> 1.00005만)
+			if fracPart != "" && unit > 0 && strings.Trim(intPart[(i+1)*len(digitsHangul):], "0") == "" {
+				part := groupDigits(group)
+				if frac := strings.TrimRight(strings.Repeat("0", unit*len(digitsHangul))+fracPart, "0"); frac != "" {
+					part += "." + frac
+				}
+				parts = append(parts, part+digitsHangul2[unit])
+				fracPart = ""
+				break
+			}
+			parts = append(parts, groupDigits(group)+digitsHangul2[unit])
+			continue
+		}
+		if opts.OmitOne && unit == 1 && group == "0001" {
+			parts = append(parts, digitsHangul2[unit])
+			continue
+		}
+		parts = append(parts, groupHangul(group, opts.OmitOne)+digitsHangul2[unit])
+	}
+
+	if opts.Style == NumberMixed {
+		if len(parts) == 0 || (fracPart != "" && intPart[len(intPart)-len(digitsHangul):] == "0000") {
+			parts = append(parts, "0")
+		}
+		if fracPart != "" {
+			parts[len(parts)-1] += "." + fracPart
+		}
+		result := strings.Join(parts, " ")
+		if negative && result != "0" {
+			result = "-" + result
+		}
+		return result
+	}
+
+	if len(parts) == 0 {
+		parts = append(parts, numberHanguls[0])
+	}
+	if fracPart != "" {
+		var sb strings.Builder
+		sb.WriteString("점")
+		for _, ch := range fracPart {
+			sb.WriteString(numberHanguls[ch-'0'])
+		}
+		parts[len(parts)-1] += sb.String()
+	}
+
+	sep := ""
+	if opts.Style == NumberSpaced {
+		sep = " "
+	}
+	result := strings.Join(parts, sep)
+	if negative && result != numberHanguls[0] {
+		result = "마이너스 " + result
+	}
+	return result
+}
+
+// groupHangul 네 자리 숫자를 한글로 씁니다. (0203 -> 이백삼)
+func groupHangul(group string, omitOne bool) string {
+	var sb strings.Builder
+	for i, ch := range group {
+		if ch == '0' {
+			continue
+		}
+
+		digit := len(group) - i - 1
+		if !(omitOne && ch == '1' && digit > 0) {
+			sb.WriteString(numberHanguls[ch-'0'])
+		}
+		sb.WriteString(digitsHangul[digit])
+	}
+	return sb.String()
+}
+
+// groupDigits 네 자리 숫자를 쉼표를 넣은 아라비아 숫자로 씁니다. (0203 -> 203, 2345 -> 2,345)
+func groupDigits(group string) string {
+	group = strings.TrimLeft(group, "0")
+	if len(group) == len(digitsHangul) {
+		return group[:1] + "," + group[1:]
+	}
+	return group
+}
+
+// HangulToNumber 한글로 쓴 숫자를 읽어 반환합니다.
+// 한자어 수사, 고유어 수사, 아라비아 숫자를 섞어 쓸 수 있고 무량대수까지 읽습니다.
+// 한자어 숫자를 이어 쓰면 자릿수대로 읽고, '구'는 숫자로 읽을 수 없을 때만 단위(溝)로 읽습니다.
+// 공백과 쉼표는 무시합니다.
+//
+//	HangulToNumber("삼억 이천만 오백") // 320000500
+//	HangulToNumber("3억 2천만")       // 320000000
+//	HangulToNumber("일점오")          // 1.5
+//	HangulToNumber("3.5억")           // 350000000
+//	HangulToNumber("일구구")          // 199
+//	HangulToNumber("스물셋")          // 23
+func HangulToNumber(str string) (*big.Float, error) {
+	r, err := parseHangulNumber(str)
+	if err != nil {
+		err.Func = "HangulToNumber"
+		return nil, err
+	}
+	return new(big.Float).SetPrec(numberPrec).SetRat(r), nil
+}
+
+// HangulToInt 한글로 쓴 정수를 읽어 반환합니다.
+// 소수 부분이 있으면 ErrNumberFraction 오류를 반환합니다.
+func HangulToInt(str string) (*big.Int, error) {
+	r, err := parseHangulNumber(str)
+	if err != nil {
+		err.Func = "HangulToInt"
+		return nil, err
+	}
+	if !r.IsInt() {
+		return nil, &NumberError{Func: "HangulToInt", Num: str, Err: ErrNumberFraction}
+	}
+	return new(big.Int).Set(r.Num()), nil
+}
+
+// parseHangulNumber 한글 숫자를 읽어 유리수로 반환합니다.
+func parseHangulNumber(str string) (*big.Rat, *NumberError) {
+	syntaxError := &NumberError{Num: str, Err: ErrNumberSyntax}
+
+	s := strings.Map(func(r rune) rune {
+		if r == ',' || r == ' ' || r == '\t' {
+			return -1
+		}
+		return r
+	}, str)
+
+	negative := false
+	for _, sign := range []string{"마이너스", "-"} {
+		if rest, ok := strings.CutPrefix(s, sign); ok {
+			s, negative = rest, true
+			break
+		}
+	}
+	if rest, ok := strings.CutPrefix(s, "+"); ok && !negative {
+		s = rest
+	}
+
+	intPart, fracPart, hasFrac := strings.Cut(strings.ReplaceAll(s, ".", "점"), "점")
+	if intPart == "" {
+		return nil, syntaxError
+	}
+
+	n, ok := parseHangulInt(intPart)
+	if !ok {
+		return nil, syntaxError
+	}
+	result := new(big.Rat).SetInt(n)
+
+	if hasFrac {
+		frac, ok := parseHangulFraction(fracPart)
+		if !ok {
+			// 만 단위 안의 소수 뒤에 큰 단위를 붙여 쓸 수 있습니다. (3.5억)
+			frac, ok = parseFractionWithUnit(n, fracPart)
+			if !ok {
+				return nil, syntaxError
+			}
+			result.SetInt64(0)
+		}
+		result.Add(result, frac)
+	}
+
+	if negative {
+		result.Neg(result)
+	}
+	return result, nil
+}
+
+// parseHangulInt 한글 숫자의 정수 부분을 읽습니다.
+// '구'처럼 숫자와 단위로 모두 읽히는 낱말이 있으므로 가능한 낱말 나누기를 차례로 시도합니다.
+// 같은 위치에서 같은 계산 상태로 실패한 나누기는 다시 시도하지 않으므로 입력의 길이에 비례하는 시간이 걸립니다.
+func parseHangulInt(s string) (*big.Int, bool) {
+	failed := make(map[numberEvalKey]bool)
+
+	var walk func(rest string, eval *numberEval) (*big.Int, bool)
+	walk = func(rest string, eval *numberEval) (*big.Int, bool) {
+		if rest == "" {
+			return eval.result()
+		}
+
+		key := eval.key(len(rest))
+		if failed[key] {
+			return nil, false
+		}
+
+		try := func(token numberToken) (*big.Int, bool) {
+			next := eval.clone()
+			if !next.step(token) {
+				return nil, false
+			}
+			return walk(rest[len(token.text):], next)
+		}
+
+		if digits := leadingDigits(rest); digits != "" {
+			if n, ok := try(numberToken{text: digits, kind: numberDigit, value: -1}); ok {
+				return n, true
+			}
+		} else {
+			for _, token := range numberTokens {
+				if !strings.HasPrefix(rest, token.text) {
+					continue
+				}
+				if n, ok := try(token); ok {
+					return n, true
+				}
+			}
+		}
+
+		failed[key] = true
+		return nil, false
+	}
+
+	return walk(s, newNumberEval())
+}
+
+// numberEval 나눈 낱말을 차례로 계산하는 상태
+// 큰 단위(만, 억 …)와 작은 단위(십, 백, 천)는 각각 큰 것부터 나와야 합니다.
+type numberEval struct {
+	total     *big.Int
+	section   *big.Int // 큰 단위 아래의 값
+	pending   *big.Int // 아직 단위가 붙지 않은 숫자
+	lastSmall int      // 현재 구간에서 마지막으로 나온 작은 단위의 지수
+	lastLarge int      // 마지막으로 나온 큰 단위의 지수
+	empty     bool     // 아무 숫자도 나오지 않았는지
+	run       bool     // pending 이 한자어 숫자를 이어 쓴 것인지
+}
+
+// numberEvalKey 남은 입력의 길이와, 앞으로의 계산 결과가 달라질 수 있는 상태
+type numberEvalKey struct {
+	rest        int
+	pending     int // 0: 없음, 1: 10 미만, 2: 10 이상
+	lastSmall   int
+	lastLarge   int
+	empty       bool
+	sectionZero bool
+	run         bool
+}
+
+// newNumberEval 계산을 시작합니다.
+func newNumberEval() *numberEval {
+	return &numberEval{total: new(big.Int), section: new(big.Int), lastSmall: 4, lastLarge: -1, empty: true}
+}
+
+// clone 상태를 복사합니다.
+func (e *numberEval) clone() *numberEval {
+	c := *e
+	c.total = new(big.Int).Set(e.total)
+	c.section = new(big.Int).Set(e.section)
+	if e.pending != nil {
+		c.pending = new(big.Int).Set(e.pending)
+	}
+	return &c
+}
+
+// key 남은 입력의 길이와 함께 상태를 비교할 수 있는 값으로 만듭니다.
+func (e *numberEval) key(rest int) numberEvalKey {
+	pending := 0
+	if e.pending != nil {
+		pending = 1
+		if e.pending.Cmp(big.NewInt(10)) >= 0 {
+			pending = 2
+		}
+	}
+	return numberEvalKey{
+		rest:        rest,
+		pending:     pending,
+		lastSmall:   e.lastSmall,
+		lastLarge:   e.lastLarge,
+		empty:       e.empty,
+		sectionZero: e.section.Sign() == 0,
+		run:         e.run,
+	}
+}
+
+// step 낱말 하나를 계산합니다. 올바르지 않은 차례이면 false 를 반환합니다.
+func (e *numberEval) step(token numberToken) bool {
+	switch token.kind {
+	case numberDigit:
+		if e.pending != nil {
+			// 한자어 숫자를 이어 쓰면 자릿수대로 읽습니다. (구구 -> 99)
+			if !e.run || !token.sino {
+				return false
+			}
+			e.pending.Mul(e.pending, big.NewInt(10))
+			e.pending.Add(e.pending, big.NewInt(int64(token.value)))
+			return true
+		}
+		e.run = token.sino
+		if token.value < 0 {
+			e.pending, _ = new(big.Int).SetString(token.text, 10)
+		} else {
+			e.pending = big.NewInt(int64(token.value))
+		}
+		e.empty = false
+	case numberNativeTens:
+		if e.pending != nil || e.lastSmall <= 1 {
+			return false
+		}
+		e.section.Add(e.section, big.NewInt(int64(token.value)))
+		e.lastSmall = 1
+		e.empty = false
+	case numberSmallUnit:
+		if token.value >= e.lastSmall {
+			return false
+		}
+		multiplier := big.NewInt(1)
+		if e.pending != nil {
+			if e.pending.Cmp(big.NewInt(10)) >= 0 {
+				return false
+			}
+			multiplier = e.pending
+		}
+		e.section.Add(e.section, multiplier.Mul(multiplier, pow10(token.value)))
+		e.pending = nil
+		e.lastSmall = token.value
+		e.empty = false
+	case numberLargeUnit:
+		if e.lastLarge >= 0 && token.value >= e.lastLarge {
+			return false
+		}
+		// '구'는 숫자 뒤에서만 단위(溝)로 읽습니다.
+		if token.text == "구" && e.pending == nil {
+			return false
+		}
+		if e.pending != nil {
+			e.section.Add(e.section, e.pending)
+			e.pending = nil
+		}
+		if e.section.Sign() == 0 {
+			if !e.empty {
+				return false
+			}
+			// '만', '억'처럼 단위만 쓰면 1로 읽습니다.
+			e.section.SetInt64(1)
+		}
+		e.total.Add(e.total, e.section.Mul(e.section, pow10(token.value)))
+		e.section = new(big.Int)
+		e.lastSmall = 4
+		e.lastLarge = token.value
+		e.empty = false
+	}
+	return true
+}
+
+// result 계산을 마치고 값을 반환합니다.
+func (e *numberEval) result() (*big.Int, bool) {
+	if e.empty {
+		return nil, false
+	}
+	n := new(big.Int).Set(e.section)
+	if e.pending != nil {
+		n.Add(n, e.pending)
+	}
+	return n.Add(n, e.total), true
+}
+
+// parseFractionWithUnit 큰 단위로 끝나는 소수 부분을 정수 부분 n 과 함께 읽습니다. (3, 5억 -> 350000000)
+// 정수 부분은 만보다 작아야 하며, 숫자로도 읽히는 '구'는 단위로 보지 않습니다.
+func parseFractionWithUnit(n *big.Int, s string) (*big.Rat, bool) {
+	if n.Cmp(big.NewInt(10000)) >= 0 {
+		return nil, false
+	}
+	for i, unit := range digitsHangul2 {
+		rest, ok := strings.CutSuffix(s, unit)
+		if unit == "" || unit == "구" || !ok {
+			continue
+		}
+		frac, ok := parseHangulFraction(rest)
+		if !ok {
+			continue
+		}
+		frac.Add(frac, new(big.Rat).SetInt(n))
+		return frac.Mul(frac, new(big.Rat).SetInt(pow10(i*len(digitsHangul)))), true
+	}
+	return nil, false
+}
+
+// parseHangulFraction 소수 부분을 읽습니다. (오 -> 0.5, 일영이 -> 0.102)
+func parseHangulFraction(s string) (*big.Rat, bool) {
+	if s == "" {
+		return nil, false
+	}
+
+	num := new(big.Int)
+	den := big.NewInt(1)
+	ten := big.NewInt(10)
+	for s != "" {
+		r, size := utf8.DecodeRuneInString(s)
+		digit, ok := fractionDigits[s[:size]]
+		if r >= '0' && r <= '9' {
+			digit, ok = int(r-'0'), true
+		}
+		if !ok {
+			return nil, false
+		}
+		num.Mul(num, ten).Add(num, big.NewInt(int64(digit)))
+		den.Mul(den, ten)
+		s = s[size:]
+	}
+	return new(big.Rat).SetFrac(num, den), true
+}
+
+// leadingDigits 문자열 앞의 아라비아 숫자를 반환합니다.
+func leadingDigits(s string) string {
+	i := 0
+	for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+		i++
+	}
+	return s[:i]
+}
+
+// pow10 10의 exp 제곱을 반환합니다.
+func pow10(exp int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(exp)), nil)
+}
+
+// buildNumberTokens 숫자 표에서 한글 숫자의 낱말을 만듭니다.
+func buildNumberTokens() []numberToken {
+	var tokens []numberToken
+
+	for i, v := range numberHanguls {
+		tokens = append(tokens, numberToken{text: v, kind: numberDigit, value: i, sino: true})
+	}
+	tokens = append(tokens,
+		numberToken{text: "공", kind: numberDigit, value: 0, sino: true},
+		numberToken{text: "륙", kind: numberDigit, value: 6, sino: true},
+	)
+	for i, v := range nativeNumberHanguls {
+		if v == "" {
+			continue
+		}
+		tokens = append(tokens, numberToken{text: v, kind: numberDigit, value: i})
+	}
+	for k, v := range nativeShortHanguls {
+		for i, native := range nativeNumberHanguls {
+			if native == k {
+				tokens = append(tokens, numberToken{text: v, kind: numberDigit, value: i})
+			}
+		}
+		for i, native := range nativeTensHanguls {
+			if native == k {
+				tokens = append(tokens, numberToken{text: v, kind: numberNativeTens, value: i * 10})
+			}
+		}
+	}
+	for i, v := range nativeTensHanguls {
+		if v == "" {
+			continue
+		}
+		tokens = append(tokens, numberToken{text: v, kind: numberNativeTens, value: i * 10})
+	}
+	for i, v := range digitsHangul {
+		if v == "" {
+			continue
+		}
+		tokens = append(tokens, numberToken{text: v, kind: numberSmallUnit, value: i})
+	}
+	for i, v := range digitsHangul2 {
+		if v == "" {
+			continue
+		}
+		tokens = append(tokens, numberToken{text: v, kind: numberLargeUnit, value: i * len(digitsHangul)})
+	}
+
+	sort.Slice(tokens, func(i, j int) bool {
+		a, b := tokens[i], tokens[j]
+		if len(a.text) != len(b.text) {
+			return len(a.text) > len(b.text)
+		}
+		if a.kind != b.kind {
+			return a.kind < b.kind
+		}
+		return a.text < b.text
+	})
+	return tokens
+}
@@ -0,0 +1,242 @@
+package gohangul
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+)
+
+func BenchmarkHangulToNumber(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		HangulToNumber("삼억 이천만 오백")
+	}
+}
+
+func TestNumberToHangulWith(t *testing.T) {
+	full := NumberOptions{}
+	spaced := NumberOptions{Style: NumberSpaced}
+	mixed := NumberOptions{Style: NumberMixed}
+	omitOne := NumberOptions{OmitOne: true}
+	tests := []struct {
+		number   string
+		opts     NumberOptions
+		expected string
+	}{
+		{"", full, ""},
+		{"0", full, "영"},
+		{"-3", full, "마이너스 삼"},
+		{"-0", full, "영"},
+		{"0.5", full, "영점오"},
+		{"-1.25", full, "마이너스 일점이오"},
+		{"123450000", full, "일억이천삼백사십오만"},
+		{"123450000", spaced, "일억 이천삼백사십오만"},
+		{"102001030", spaced, "일억 이백만 일천삼십"},
+		{"-102001030", spaced, "마이너스 일억 이백만 일천삼십"},
+		{"123450000", mixed, "1억 2,345만"},
+		{"123456789", mixed, "1억 2,345만 6,789"},
+		{"100000203", mixed, "1억 203"},
+		{"1,230,000", mixed, "123만"},
+		{"0", mixed, "0"},
+		{"-12345.67", mixed, "-1만 2,345.67"},
+		{"10000.5", mixed, "1.00005만"},
+		{"120000000.25", mixed, "1억 2,000.000025만"},
+		{"100000000.25", mixed, "1.0000000025억"},
+		{"-10000.5", mixed, "-1.00005만"},
+		{"100010000.5", mixed, "1억 1.00005만"},
+		{"10000.0", mixed, "1만"},
+		{"1.5", mixed, "1.5"},
+		{"10", full, "일십"},
+		{"10", omitOne, "십"},
+		{"110", omitOne, "백십"},
+		{"1111", omitOne, "천백십일"},
+		{"10000", omitOne, "만"},
+		{"11000", omitOne, "만천"},
+		{"110000", omitOne, "십일만"},
+		{"100000000", omitOne, "일억"},
+		{"101000000", NumberOptions{Style: NumberSpaced, OmitOne: true}, "일억 백만"},
+	}
+
+	for _, test := range tests {
+		result := NumberToHangulWith(test.number, test.opts)
+		if result != test.expected {
+			t.Errorf("NumberToHangulWith(%q, %+v) = %q; want %q", test.number, test.opts, result, test.expected)
+		}
+	}
+}
+
+func TestInt64ToHangul(t *testing.T) {
+	tests := []struct {
+		number   int64
+		expected string
+	}{
+		{0, "영"},
+		{-3, "마이너스 삼"},
+		{math.MaxInt64, "구백이십이경삼천삼백칠십이조삼백육십팔억오천사백칠십칠만오천팔백칠"},
+		{math.MinInt64, "마이너스 구백이십이경삼천삼백칠십이조삼백육십팔억오천사백칠십칠만오천팔백팔"},
+	}
+
+	for _, test := range tests {
+		result := Int64ToHangul(test.number, NumberOptions{})
+		if result != test.expected {
+			t.Errorf("Int64ToHangul(%d) = %q; want %q", test.number, result, test.expected)
+		}
+	}
+}
+
+func TestUint64ToHangul(t *testing.T) {
+	result := Uint64ToHangul(math.MaxUint64, NumberOptions{Style: NumberMixed})
+	want := "1,844경 6,744조 737억 955만 1,615"
+	if result != want {
+		t.Errorf("Uint64ToHangul(%d) = %q; want %q", uint64(math.MaxUint64), result, want)
+	}
+}
+
+func TestBigIntToHangul(t *testing.T) {
+	n, _ := new(big.Int).SetString("-1"+strings.Repeat("0", 68), 10)
+	result, err := BigIntToHangul(n, NumberOptions{OmitOne: true})
+	if err != nil || result != "마이너스 일무량대수" {
+		t.Errorf("BigIntToHangul(%s) = %q, %v; want %q", n, result, err, "마이너스 일무량대수")
+	}
+
+	n.Mul(n, big.NewInt(10000))
+	_, err = BigIntToHangul(n, NumberOptions{})
+	if !errors.Is(err, ErrNumberRange) {
+		t.Errorf("BigIntToHangul(%s) error = %v; want %v", n, err, ErrNumberRange)
+	}
+}
+
+func TestBigFloatToHangul(t *testing.T) {
+	result, err := BigFloatToHangul(big.NewFloat(-1234.5), NumberOptions{Style: NumberSpaced})
+	if err != nil || result != "마이너스 일천이백삼십사점오" {
+		t.Errorf("BigFloatToHangul(-1234.5) = %q, %v; want %q", result, err, "마이너스 일천이백삼십사점오")
+	}
+
+	_, err = BigFloatToHangul(new(big.Float).SetInf(false), NumberOptions{})
+	if !errors.Is(err, ErrNumberRange) {
+		t.Errorf("BigFloatToHangul(+Inf) error = %v; want %v", err, ErrNumberRange)
+	}
+}
+
+func TestHangulToNumber(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"영", "0"},
+		{"공", "0"},
+		{"일", "1"},
+		{"십", "10"},
+		{"일십이", "12"},
+		{"십이", "12"},
+		{"이십구", "29"},
+		{"백", "100"},
+		{"만", "10000"},
+		{"십만", "100000"},
+		{"일백만점일영이삼", "1000000.1023"},
+		{"일억이백만일천삼십", "102001030"},
+		{"삼억 이천만 오백", "320000500"},
+		{"3억 2천만", "320000000"},
+		{"1,500만", "15000000"},
+		{"12만 3,456", "123456"},
+		{"일점오", "1.5"},
+		{"1.5", "1.5"},
+		{"스물셋", "23"},
+		{"스무", "20"},
+		{"아흔아홉", "99"},
+		{"열한", "11"},
+		{"일곱", "7"},
+		{"백스물세", "123"},
+		{"마이너스 삼십", "-30"},
+		{"-12", "-12"},
+		{"일무량대수", "1" + strings.Repeat("0", 68)},
+		{"구천구백구십구무량대수", "9999" + strings.Repeat("0", 68)},
+		{"2구", "2" + strings.Repeat("0", 32)},
+		{"구", "9"},
+		{"이구", "29"},
+		{"구구", "99"},
+		{"일구구", "199"},
+		{"이공이사", "2024"},
+		{"일구오천", "1" + strings.Repeat("0", 28) + "5000"},
+		{"3.5억", "350000000"},
+		{"삼점오억", "350000000"},
+		{"1.25만", "12500"},
+		{"삼점오구", "3.59"},
+	}
+
+	for _, test := range tests {
+		result, err := HangulToNumber(test.input)
+		if err != nil {
+			t.Errorf("HangulToNumber(%q) error: %v", test.input, err)
+			continue
+		}
+		want, _ := new(big.Float).SetPrec(numberPrec).SetString(test.expected)
+		if result.Cmp(want) != 0 {
+			t.Errorf("HangulToNumber(%q) = %s; want %s", test.input, result.Text('f', -1), test.expected)
+		}
+	}
+}
+
+func TestHangulToNumber_Error(t *testing.T) {
+	tests := []string{
+		"",
+		"점오",
+		"일점",
+		"삼사십",
+		"12345.5만",
+		"백천",
+		"만억",
+		"사과",
+		"일점오점",
+		"스물열",
+		"15백",
+	}
+
+	for _, test := range tests {
+		_, err := HangulToNumber(test)
+		if !errors.Is(err, ErrNumberSyntax) {
+			t.Errorf("HangulToNumber(%q) error = %v; want %v", test, err, ErrNumberSyntax)
+		}
+
+		var numErr *NumberError
+		if errors.As(err, &numErr) && (numErr.Func != "HangulToNumber" || numErr.Num != test) {
+			t.Errorf("HangulToNumber(%q) error = %#v", test, numErr)
+		}
+	}
+}
+
+func TestHangulToInt(t *testing.T) {
+	result, err := HangulToInt("삼억 이천만 오백")
+	if err != nil || result.Cmp(big.NewInt(320000500)) != 0 {
+		t.Errorf("HangulToInt(%q) = %v, %v; want 320000500", "삼억 이천만 오백", result, err)
+	}
+
+	_, err = HangulToInt("일점오")
+	if !errors.Is(err, ErrNumberFraction) {
+		t.Errorf("HangulToInt(%q) error = %v; want %v", "일점오", err, ErrNumberFraction)
+	}
+	if err != nil && err.Error() != `gohangul.HangulToInt: parsing "일점오": unexpected fraction` {
+		t.Errorf("HangulToInt(%q) error = %q", "일점오", err.Error())
+	}
+}
+
+// TestHangulToNumberLongInput '구'가 반복되는 긴 입력도 입력의 길이에 비례하는 시간에 읽는지 확인합니다.
+func TestHangulToNumberLongInput(t *testing.T) {
+	for _, input := range []string{
+		strings.Repeat("구", 200) + "x",
+		strings.Repeat("구", 200),
+		strings.Repeat("구천", 100),
+	} {
+		start := time.Now()
+		HangulToNumber(input)
+		if elapsed := time.Since(start); elapsed > time.Second {
+			t.Errorf("HangulToNumber(%q...) took %v", input[:9], elapsed)
+		}
+	}
+
+	if _, err := HangulToNumber(strings.Repeat("구", 22) + "x"); !errors.Is(err, ErrNumberSyntax) {
+		t.Errorf("HangulToNumber(구…x) error = %v; want %v", err, ErrNumberSyntax)
+	}
+}
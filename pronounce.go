@@ -0,0 +1,307 @@
+package gohangul
+
+var (
+	// 받침 -> 대표음
+	representativeMap = map[Jamo]Jamo{
+		'ㄱ': 'ㄱ',
+		'ㄲ': 'ㄱ',
+		'ㄳ': 'ㄱ',
+		'ㄴ': 'ㄴ',
+		'ㄵ': 'ㄴ',
+		'ㄶ': 'ㄴ',
+		'ㄷ': 'ㄷ',
+		'ㄹ': 'ㄹ',
+		'ㄺ': 'ㄱ',
+		'ㄻ': 'ㅁ',
+		'ㄼ': 'ㄹ',
+		'ㄽ': 'ㄹ',
+		'ㄾ': 'ㄹ',
+		'ㄿ': 'ㅂ',
+		'ㅀ': 'ㄹ',
+		'ㅁ': 'ㅁ',
+		'ㅂ': 'ㅂ',
+		'ㅄ': 'ㅂ',
+		'ㅅ': 'ㄷ',
+		'ㅆ': 'ㄷ',
+		'ㅇ': 'ㅇ',
+		'ㅈ': 'ㄷ',
+		'ㅊ': 'ㄷ',
+		'ㅋ': 'ㄱ',
+		'ㅌ': 'ㄷ',
+		'ㅍ': 'ㅂ',
+		'ㅎ': 'ㄷ',
+	}
+
+	// 겹받침 -> 앞 자음, 뒤 자음
+	splitBatchimMap = map[Jamo][2]Jamo{
+		'ㄳ': {'ㄱ', 'ㅅ'},
+		'ㄵ': {'ㄴ', 'ㅈ'},
+		'ㄺ': {'ㄹ', 'ㄱ'},
+		'ㄻ': {'ㄹ', 'ㅁ'},
+		'ㄼ': {'ㄹ', 'ㅂ'},
+		'ㄽ': {'ㄹ', 'ㅅ'},
+		'ㄾ': {'ㄹ', 'ㅌ'},
+		'ㄿ': {'ㄹ', 'ㅍ'},
+		'ㅄ': {'ㅂ', 'ㅅ'},
+	}
+
+	// ㅎ 받침 -> ㅎ 을 뺀 나머지 받침
+	hieutBatchimMap = map[Jamo]Jamo{
+		'ㅎ': 0,
+		'ㄶ': 'ㄴ',
+		'ㅀ': 'ㄹ',
+	}
+
+	// 받침 -> 모음 'ㅣ', 'ㅕ' 앞에서 구개음화된 받침, 초성
+	palatalMap = map[Jamo][2]Jamo{
+		'ㄷ': {0, 'ㅈ'},
+		'ㅌ': {0, 'ㅊ'},
+		'ㄾ': {'ㄹ', 'ㅊ'},
+	}
+
+	// 예사소리 -> 거센소리
+	aspirateMap = map[Jamo]Jamo{
+		'ㄱ': 'ㅋ',
+		'ㄷ': 'ㅌ',
+		'ㅂ': 'ㅍ',
+		'ㅈ': 'ㅊ',
+	}
+
+	// 대표음 -> 비음
+	nasalMap = map[Jamo]Jamo{
+		'ㄱ': 'ㅇ',
+		'ㄷ': 'ㄴ',
+		'ㅂ': 'ㅁ',
+	}
+
+	// 예사소리 -> 된소리
+	tenseMap = map[Jamo]Jamo{
+		'ㄱ': 'ㄲ',
+		'ㄷ': 'ㄸ',
+		'ㅂ': 'ㅃ',
+		'ㅅ': 'ㅆ',
+		'ㅈ': 'ㅉ',
+	}
+
+	// 뒤 음절의 예사소리를 된소리로 만드는 받침
+	tensingBatchim = map[Jamo]bool{
+		'ㄱ': true,
+		'ㄲ': true,
+		'ㄳ': true,
+		'ㄵ': true,
+		'ㄷ': true,
+		'ㄺ': true,
+		'ㄻ': true,
+		'ㄼ': true,
+		'ㄾ': true,
+		'ㄿ': true,
+		'ㅂ': true,
+		'ㅄ': true,
+		'ㅅ': true,
+		'ㅆ': true,
+		'ㅈ': true,
+		'ㅊ': true,
+		'ㅋ': true,
+		'ㅌ': true,
+		'ㅍ': true,
+	}
+)
+
+// pronunciation 적용할 발음 규칙
+type pronunciation struct {
+	tensing   bool // 된소리되기
+	vowel     bool // 'ㅢ', 'ㅕ'의 단모음화
+	keepHieut bool // 'ㄱ, ㄷ, ㅂ' 받침 뒤의 'ㅎ'을 거센소리로 바꾸지 않음 (피동, 사동 접미사 '히'는 빼고)
+}
+
+var (
+	// 표준 발음법의 모든 규칙을 적용합니다.
+	standardPronunciation = pronunciation{tensing: true, vowel: true}
+	// 로마자 표기법은 된소리되기와 모음의 변화를 표기에 반영하지 않고,
+	// 체언에서 'ㄱ, ㄷ, ㅂ' 뒤의 'ㅎ'은 밝혀 적습니다. (묵호 Mukho, 집현전 Jiphyeonjeon)
+	romajaPronunciation = pronunciation{keepHieut: true}
+)
+
+// Pronounce 문자열을 표준 발음법에 따른 발음으로 변환합니다.
+func Pronounce(str string) string {
+	return Disassemble(str).Pronounce().Assemble()
+}
+
+// apply 음절 사이에서 일어나는 소리의 변화를 적용합니다.
+// 연음, 구개음화, 거센소리되기, ㅎ 탈락, 비음화, 유음화, 받침의 대표음화를 처리하고
+// 규칙에 따라 된소리되기와 모음의 변화를 처리합니다.
+func (p pronunciation) apply(d Daneo) Daneo {
+	result := make(Daneo, len(d))
+	copy(result, d)
+
+	for i := range result {
+		if !result[i].isSyllable() {
+			continue
+		}
+
+		if !result[i].Jongseong.Empty() {
+			if next := result.At(i + 1); next.isSyllable() {
+				jong, cho := p.changeBoundary(result[i], next)
+				result[i].Jongseong = jong.toChoseong()
+				result[i+1].Choseong = cho.toChoseong()
+			} else {
+				result[i].Jongseong = neutralize(result[i], next).toChoseong()
+			}
+		}
+		if p.vowel {
+			result[i].Jungseong = changeVowel(result[i]).toChoseong()
+		}
+	}
+	return result
+}
+
+// changeBoundary 앞 음절의 받침과 뒤 음절의 초성이 만날 때 바뀌는 받침과 초성을 반환합니다.
+func (p pronunciation) changeBoundary(prev, next Eumjeol) (Jamo, Jamo) {
+	jong := prev.Jongseong.toLetter()
+	cho := next.Choseong.toLetter()
+	vowel := next.Jungseong.toLetter()
+
+	if rest, ok := hieutBatchimMap[jong]; ok {
+		switch cho {
+		case 'ㄱ', 'ㄷ', 'ㅈ':
+			return rest, aspirateMap[cho]
+		case 'ㅅ':
+			if p.tensing {
+				return rest, 'ㅆ'
+			}
+			return rest, cho
+		case 'ㅇ':
+			if rest == 0 {
+				return 0, cho
+			}
+			return 0, rest
+		case 'ㄴ':
+			if rest == 'ㄹ' {
+				return 'ㄹ', 'ㄹ'
+			}
+			return 'ㄴ', 'ㄴ'
+		}
+	}
+
+	switch cho {
+	case 'ㅇ':
+		if jong == 'ㅇ' {
+			return jong, cho
+		}
+		if v, ok := palatalMap[jong]; ok && isPalatalVowel(vowel) {
+			return v[0], v[1]
+		}
+		if v, ok := splitBatchimMap[jong]; ok {
+			if p.tensing && v[1] == 'ㅅ' {
+				return v[0], 'ㅆ'
+			}
+			return v[0], v[1]
+		}
+		return 0, jong
+	case 'ㅎ':
+		if jong == 'ㄷ' && isPalatalVowel(vowel) {
+			return 0, 'ㅊ'
+		}
+		if v, ok := splitBatchimMap[jong]; ok {
+			if a, ok := aspirateMap[v[1]]; ok {
+				return v[0], a
+			}
+		}
+		if jong == 'ㅈ' {
+			return 0, 'ㅊ'
+		}
+		if p.keepHieut && (jong == 'ㄱ' || jong == 'ㄷ' || jong == 'ㅂ') && !isPassiveSuffix(next) {
+			return jong, cho
+		}
+		final := neutralize(prev, next)
+		if a, ok := aspirateMap[final]; ok {
+			return 0, a
+		}
+		return final, cho
+	}
+
+	final := neutralize(prev, next)
+	switch cho {
+	case 'ㄴ', 'ㅁ':
+		if v, ok := nasalMap[final]; ok {
+			final = v
+		}
+		if final == 'ㄹ' && cho == 'ㄴ' {
+			cho = 'ㄹ'
+		}
+	case 'ㄹ':
+		if final == 'ㄴ' {
+			final = 'ㄹ'
+		} else if final != 'ㄹ' {
+			cho = 'ㄴ'
+			if v, ok := nasalMap[final]; ok {
+				final = v
+			}
+		}
+	default:
+		if v, ok := tenseMap[cho]; ok && p.tensing && tensingBatchim[jong] {
+			cho = v
+		}
+	}
+	return final, cho
+}
+
+// isPassiveSuffix 음절이 용언의 피동, 사동 접미사 '히'로 시작하는지 확인합니다. (잡히다, 잡혀, 먹힌)
+// 체언이 아닌 용언이므로 로마자 표기에서도 거센소리로 적습니다.
+func isPassiveSuffix(e Eumjeol) bool {
+	switch e.Jungseong.toLetter() {
+	case 'ㅣ':
+		return true
+	case 'ㅕ':
+		return e.Jongseong.Empty() || e.Jongseong.toLetter() == 'ㅆ'
+	}
+	return false
+}
+
+// neutralize 받침을 대표음으로 바꿉니다.
+// 겹받침은 뒤 음절에 따라 발음되는 자음을 고릅니다.
+func neutralize(prev, next Eumjeol) Jamo {
+	jong := prev.Jongseong.toLetter()
+
+	switch jong {
+	case 'ㄺ':
+		if next.Choseong.toLetter() == 'ㄱ' {
+			return 'ㄹ'
+		}
+	case 'ㄼ':
+		// 밟-, 넓죽하다, 넓둥글다 는 [ㅂ]으로 발음합니다.
+		if prev.Choseong.toLetter() == 'ㅂ' && prev.Jungseong.toLetter() == 'ㅏ' {
+			return 'ㅂ'
+		}
+		if prev.Choseong.toLetter() == 'ㄴ' && prev.Jungseong.toLetter() == 'ㅓ' &&
+			(next.String() == "죽" || next.String() == "둥") {
+			return 'ㅂ'
+		}
+	}
+
+	if v, ok := representativeMap[jong]; ok {
+		return v
+	}
+	return jong
+}
+
+// isPalatalVowel 구개음화를 일으키는 모음인지 확인합니다.
+// 'ㅕ'는 '이어'가 줄어든 경우입니다. (붙여, 닫혀)
+func isPalatalVowel(vowel Jamo) bool {
+	return vowel == 'ㅣ' || vowel == 'ㅕ'
+}
+
+// changeVowel 음절의 발음되는 모음을 반환합니다.
+// 자음 뒤의 'ㅢ'는 [ㅣ]로, 'ㅈ, ㅉ, ㅊ' 뒤의 'ㅕ'는 [ㅓ]로 발음합니다.
+func changeVowel(e Eumjeol) Jamo {
+	cho := e.Choseong.toLetter()
+	vowel := e.Jungseong.toLetter()
+
+	switch {
+	case vowel == 'ㅢ' && cho != 'ㅇ':
+		return 'ㅣ'
+	case vowel == 'ㅕ' && (cho == 'ㅈ' || cho == 'ㅉ' || cho == 'ㅊ'):
+		return 'ㅓ'
+	}
+	return vowel
+}
@@ -0,0 +1,56 @@
+package gohangul
+
+import "testing"
+
+func BenchmarkPronounce(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		Pronounce("읽는다")
+	}
+}
+
+func TestPronounce(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"", ""},
+		{"읽는다", "잉는다"},
+		{"좋아", "조아"},
+		{"값이", "갑씨"},
+		{"닭이", "달기"},
+		{"앉아", "안자"},
+		{"밖에", "바께"},
+		{"있어", "이써"},
+		{"신라", "실라"},
+		{"칼날", "칼랄"},
+		{"먹는", "멍는"},
+		{"협력", "혐녁"},
+		{"종로", "종노"},
+		{"국밥", "국빱"},
+		{"앉고", "안꼬"},
+		{"밟다", "밥따"},
+		{"넓다", "널따"},
+		{"맑게", "말께"},
+		{"놓고", "노코"},
+		{"닿소", "다쏘"},
+		{"않는", "안는"},
+		{"뚫는", "뚤른"},
+		{"싫어", "시러"},
+		{"같이", "가치"},
+		{"굳이", "구지"},
+		{"굳히다", "구치다"},
+		{"닫혀", "다처"},
+		{"붙여", "부처"},
+		{"희망", "히망"},
+		{"의사", "의사"},
+		{"닭", "닥"},
+		{"꽃 한 송이", "꼳 한 송이"},
+	}
+
+	for _, test := range tests {
+		result := Pronounce(test.input)
+		if result != test.expected {
+			t.Errorf("Pronounce(%q) = %q; want %q", test.input, result, test.expected)
+		}
+	}
+}
@@ -0,0 +1,121 @@
+package gohangul
+
+import (
+	"strconv"
+	"time"
+)
+
+// RelativeTime 기준 시각 now 에서 본 t 를 '방금', '5분 전', '어제 오후', '지난주 금요일', '2년 전'처럼 씁니다.
+// hangul 이 true 이면 숫자를 수사로 읽습니다. (오 분 전, 다섯 시간 전, 두 달 전)
+// t 가 now 와 같으면 '방금'이라 쓰고, 주는 월요일부터 일요일까지로 셉니다.
+//
+//	RelativeTime(now.Add(-5*time.Minute), now)       // 5분 전
+//	RelativeTime(now.Add(-5*time.Minute), now, true) // 오 분 전
+func RelativeTime(t, now time.Time, hangul ...bool) string {
+	t = t.In(now.Location())
+	diff := t.Sub(now)
+	suffix := " 후"
+	if diff < 0 {
+		diff, suffix = -diff, " 전"
+	}
+
+	count := func(n int, counter string) string {
+		if len(hangul) > 0 && hangul[0] {
+			return CountToHangul(n, counter) + suffix
+		}
+		return strconv.Itoa(n) + counter + suffix
+	}
+
+	days := calendarDays(now, t)
+	switch {
+	case diff < time.Minute:
+		if !t.After(now) {
+			return "방금"
+		}
+		return "곧"
+	case diff < time.Hour:
+		return count(int(diff/time.Minute), "분")
+	case days == 0:
+		return count(int(diff/time.Hour), "시간")
+	case days == -1:
+		return "어제 " + meridiem(t.Hour())
+	case days == 1:
+		return "내일 " + meridiem(t.Hour())
+	case days == -2:
+		return "그저께"
+	case days == 2:
+		return "모레"
+	}
+
+	switch calendarDays(startOfWeek(now), startOfWeek(t)) / 7 {
+	case 0:
+		return "이번 주 " + Weekday(t.Weekday(), true)
+	case -1:
+		return "지난주 " + Weekday(t.Weekday(), true)
+	case 1:
+		return "다음 주 " + Weekday(t.Weekday(), true)
+	}
+
+	if days < 0 {
+		days = -days
+	}
+	switch {
+	case days < 14:
+		return count(days, "일")
+	case days < 30:
+		return count(days/7, "주")
+	case days < 365:
+		return count(days/30, "개월")
+	}
+	return count(days/365, "년")
+}
+
+// ParseRelativeTime RelativeTime 이 쓰는 표현을 기준 시각 now 에 맞추어 읽습니다.
+// '3분 전', '다섯 시간 후', '어제 오후', '그저께', '다음 주 화요일' 등을 읽고,
+// '내일 오후 세 시'처럼 ParseNaturalTime 이 읽는 표현도 읽습니다.
+// 오전, 오후만 쓰면 그 시작 시각(0시, 12시)으로 읽습니다.
+func ParseRelativeTime(phrase string, now time.Time) (time.Time, error) {
+	t, err := parseNaturalTime(phrase, now)
+	if err != nil {
+		return time.Time{}, &TimeError{Func: "ParseRelativeTime", Value: phrase, Err: err}
+	}
+	return t, nil
+}
+
+// addRelative 기준 시각에 단위 명사로 센 시간을 더합니다.
+func addRelative(now time.Time, n int, counter string) time.Time {
+	switch counter {
+	case "초":
+		return now.Add(time.Duration(n) * time.Second)
+	case "분":
+		return now.Add(time.Duration(n) * time.Minute)
+	case "시간":
+		return now.Add(time.Duration(n) * time.Hour)
+	case "일":
+		return now.AddDate(0, 0, n)
+	case "주":
+		return now.AddDate(0, 0, n*7)
+	case "개월", "달":
+		return now.AddDate(0, n, 0)
+	}
+	return now.AddDate(n, 0, 0)
+}
+
+// weekdayOfWeek 기준 시각이 속한 주에서 offset 주 떨어진 주의 요일을 반환합니다.
+func weekdayOfWeek(now time.Time, offset int, weekday time.Weekday) time.Time {
+	return startOfWeek(now).AddDate(0, 0, offset*7+(int(weekday)+6)%7)
+}
+
+// startOfWeek 기준 시각이 속한 주의 월요일을 반환합니다.
+func startOfWeek(t time.Time) time.Time {
+	return t.AddDate(0, 0, -((int(t.Weekday()) + 6) % 7))
+}
+
+// calendarDays from 의 날짜에서 to 의 날짜까지의 날수를 반환합니다.
+func calendarDays(from, to time.Time) int {
+	y1, m1, d1 := from.Date()
+	y2, m2, d2 := to.Date()
+	a := time.Date(y1, m1, d1, 0, 0, 0, 0, time.UTC)
+	b := time.Date(y2, m2, d2, 0, 0, 0, 0, time.UTC)
+	return int(b.Sub(a).Hours() / 24)
+}
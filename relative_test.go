@@ -0,0 +1,103 @@
+package gohangul
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func BenchmarkRelativeTime(b *testing.B) {
+	now := time.Date(2026, 10, 18, 15, 5, 0, 0, time.UTC)
+	t := now.Add(-5 * time.Minute)
+	for i := 0; i < b.N; i++ {
+		RelativeTime(t, now)
+	}
+}
+
+func TestRelativeTime(t *testing.T) {
+	// 2026년 10월 18일 일요일 오후 3시 5분
+	now := time.Date(2026, 10, 18, 15, 5, 0, 0, time.UTC)
+	tests := []struct {
+		t        time.Time
+		hangul   bool
+		expected string
+	}{
+		{now, false, "방금"},
+		{now.Add(-30 * time.Second), false, "방금"},
+		{now.Add(30 * time.Second), false, "곧"},
+		{now.Add(-5 * time.Minute), false, "5분 전"},
+		{now.Add(-5 * time.Minute), true, "오 분 전"},
+		{now.Add(20 * time.Minute), false, "20분 후"},
+		{now.Add(-3 * time.Hour), false, "3시간 전"},
+		{now.Add(-5 * time.Hour), true, "다섯 시간 전"},
+		{now.Add(5 * time.Hour), false, "5시간 후"},
+		{time.Date(2026, 10, 17, 14, 0, 0, 0, time.UTC), false, "어제 오후"},
+		{time.Date(2026, 10, 17, 9, 0, 0, 0, time.UTC), false, "어제 오전"},
+		{time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC), false, "내일 오전"},
+		{time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC), false, "그저께"},
+		{time.Date(2026, 10, 20, 9, 0, 0, 0, time.UTC), false, "모레"},
+		{time.Date(2026, 10, 14, 9, 0, 0, 0, time.UTC), false, "이번 주 수요일"},
+		{time.Date(2026, 10, 9, 9, 0, 0, 0, time.UTC), false, "지난주 금요일"},
+		{time.Date(2026, 10, 22, 9, 0, 0, 0, time.UTC), false, "다음 주 목요일"},
+		{time.Date(2026, 10, 4, 9, 0, 0, 0, time.UTC), false, "2주 전"},
+		{time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC), true, "이 주 전"},
+		{time.Date(2026, 10, 31, 9, 0, 0, 0, time.UTC), false, "13일 후"},
+		{time.Date(2026, 7, 18, 9, 0, 0, 0, time.UTC), false, "3개월 전"},
+		{time.Date(2026, 7, 18, 9, 0, 0, 0, time.UTC), true, "삼 개월 전"},
+		{time.Date(2024, 10, 1, 9, 0, 0, 0, time.UTC), false, "2년 전"},
+		{time.Date(2029, 1, 1, 9, 0, 0, 0, time.UTC), false, "2년 후"},
+	}
+
+	for _, test := range tests {
+		result := RelativeTime(test.t, now, test.hangul)
+		if result != test.expected {
+			t.Errorf("RelativeTime(%v, %v) = %q; want %q", test.t, test.hangul, result, test.expected)
+		}
+	}
+}
+
+func TestParseRelativeTime(t *testing.T) {
+	now := time.Date(2026, 10, 18, 15, 5, 0, 0, time.UTC)
+	tests := []struct {
+		phrase   string
+		expected time.Time
+	}{
+		{"방금", now},
+		{"5분 전", now.Add(-5 * time.Minute)},
+		{"오 분 전", now.Add(-5 * time.Minute)},
+		{"3시간 후", now.Add(3 * time.Hour)},
+		{"다섯 시간 뒤", now.Add(5 * time.Hour)},
+		{"10일 전", now.AddDate(0, 0, -10)},
+		{"2주 전", now.AddDate(0, 0, -14)},
+		{"두 달 전", now.AddDate(0, -2, 0)},
+		{"3개월 후", now.AddDate(0, 3, 0)},
+		{"2년 전", now.AddDate(-2, 0, 0)},
+		{"어제 오후", time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)},
+		{"내일 오전", time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)},
+		{"그저께", time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC)},
+		{"모레", time.Date(2026, 10, 20, 0, 0, 0, 0, time.UTC)},
+		{"지난주 금요일", time.Date(2026, 10, 9, 0, 0, 0, 0, time.UTC)},
+		{"이번 주 월요일", time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC)},
+		{"다음 주 화요일 오후 3시", time.Date(2026, 10, 20, 15, 0, 0, 0, time.UTC)},
+	}
+
+	for _, test := range tests {
+		result, err := ParseRelativeTime(test.phrase, now)
+		if err != nil || !result.Equal(test.expected) {
+			t.Errorf("ParseRelativeTime(%q) = %v, %v; want %v", test.phrase, result, err, test.expected)
+		}
+	}
+
+	for _, phrase := range []string{"", "사과 전", "5분 전 오후 3시"} {
+		if _, err := ParseRelativeTime(phrase, now); !errors.Is(err, ErrTimeSyntax) {
+			t.Errorf("ParseRelativeTime(%q) error = %v; want %v", phrase, err, ErrTimeSyntax)
+		}
+	}
+
+	for _, d := range []time.Duration{-5 * time.Minute, -3 * time.Hour, 20 * time.Minute} {
+		phrase := RelativeTime(now.Add(d), now)
+		if result, err := ParseRelativeTime(phrase, now); err != nil || !result.Equal(now.Add(d)) {
+			t.Errorf("ParseRelativeTime(%q) = %v, %v; want %v", phrase, result, err, now.Add(d))
+		}
+	}
+}
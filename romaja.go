@@ -0,0 +1,1003 @@
+package gohangul
+
+import (
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+var (
+	// 매큔-라이샤워 초성 로마자
+	choseongMcCune = map[string]string{
+		"ㄱ": "k",
+		"ㄲ": "kk",
+		"ㄴ": "n",
+		"ㄷ": "t",
+		"ㄸ": "tt",
+		"ㄹ": "r",
+		"ㅁ": "m",
+		"ㅂ": "p",
+		"ㅃ": "pp",
+		"ㅅ": "s",
+		"ㅆ": "ss",
+		"ㅇ": "",
+		"ㅈ": "ch",
+		"ㅉ": "tch",
+		"ㅊ": "ch'",
+		"ㅋ": "k'",
+		"ㅌ": "t'",
+		"ㅍ": "p'",
+		"ㅎ": "h",
+	}
+
+	// 매큔-라이샤워 유성음 사이의 초성 로마자
+	voicedChoseongMcCune = map[string]string{
+		"ㄱ": "g",
+		"ㄷ": "d",
+		"ㅂ": "b",
+		"ㅈ": "j",
+	}
+
+	// 매큔-라이샤워 중성 로마자
+	jungseongMcCune = map[string]string{
+		"ㅏ": "a",
+		"ㅐ": "ae",
+		"ㅑ": "ya",
+		"ㅒ": "yae",
+		"ㅓ": "ŏ",
+		"ㅔ": "e",
+		"ㅕ": "yŏ",
+		"ㅖ": "ye",
+		"ㅗ": "o",
+		"ㅘ": "wa",
+		"ㅙ": "wae",
+		"ㅚ": "oe",
+		"ㅛ": "yo",
+		"ㅜ": "u",
+		"ㅝ": "wŏ",
+		"ㅞ": "we",
+		"ㅟ": "wi",
+		"ㅠ": "yu",
+		"ㅡ": "ŭ",
+		"ㅢ": "ŭi",
+		"ㅣ": "i",
+	}
+
+	// 매큔-라이샤워 종성 로마자
+	jongseongMcCune = map[string]string{
+		"ㄱ": "k",
+		"ㄴ": "n",
+		"ㄷ": "t",
+		"ㄹ": "l",
+		"ㅁ": "m",
+		"ㅂ": "p",
+		"ㅇ": "ng",
+	}
+
+	// 매큔-라이샤워 반달표 -> ASCII
+	mcCuneASCIIReplacer = strings.NewReplacer("ŏ", "o", "ŭ", "u")
+
+	// 예일 초성 로마자
+	choseongYale = map[string]string{
+		"ㄱ": "k",
+		"ㄲ": "kk",
+		"ㄴ": "n",
+		"ㄷ": "t",
+		"ㄸ": "tt",
+		"ㄹ": "l",
+		"ㅁ": "m",
+		"ㅂ": "p",
+		"ㅃ": "pp",
+		"ㅅ": "s",
+		"ㅆ": "ss",
+		"ㅇ": "",
+		"ㅈ": "c",
+		"ㅉ": "cc",
+		"ㅊ": "ch",
+		"ㅋ": "kh",
+		"ㅌ": "th",
+		"ㅍ": "ph",
+		"ㅎ": "h",
+	}
+
+	// 예일 중성 로마자
+	jungseongYale = map[string]string{
+		"ㅏ": "a",
+		"ㅐ": "ay",
+		"ㅑ": "ya",
+		"ㅒ": "yay",
+		"ㅓ": "e",
+		"ㅔ": "ey",
+		"ㅕ": "ye",
+		"ㅖ": "yey",
+		"ㅗ": "o",
+		"ㅘ": "wa",
+		"ㅙ": "way",
+		"ㅚ": "oy",
+		"ㅛ": "yo",
+		"ㅜ": "wu",
+		"ㅝ": "we",
+		"ㅞ": "wey",
+		"ㅟ": "wi",
+		"ㅠ": "yu",
+		"ㅡ": "u",
+		"ㅢ": "uy",
+		"ㅣ": "i",
+	}
+
+	// 예일 종성 로마자
+	jongseongYale = map[string]string{
+		"ㄱ": "k",
+		"ㄲ": "kk",
+		"ㄳ": "ks",
+		"ㄴ": "n",
+		"ㄵ": "nc",
+		"ㄶ": "nh",
+		"ㄷ": "t",
+		"ㄹ": "l",
+		"ㄺ": "lk",
+		"ㄻ": "lm",
+		"ㄼ": "lp",
+		"ㄽ": "ls",
+		"ㄾ": "lth",
+		"ㄿ": "lph",
+		"ㅀ": "lh",
+		"ㅁ": "m",
+		"ㅂ": "p",
+		"ㅄ": "ps",
+		"ㅅ": "s",
+		"ㅆ": "ss",
+		"ㅇ": "ng",
+		"ㅈ": "c",
+		"ㅊ": "ch",
+		"ㅋ": "kh",
+		"ㅌ": "th",
+		"ㅍ": "ph",
+		"ㅎ": "h",
+	}
+
+	// 예일 로마자 -> 초성
+	yaleChoseong = map[string]Jamo{
+		"k":  'ㄱ',
+		"kk": 'ㄲ',
+		"n":  'ㄴ',
+		"t":  'ㄷ',
+		"tt": 'ㄸ',
+		"l":  'ㄹ',
+		"m":  'ㅁ',
+		"p":  'ㅂ',
+		"pp": 'ㅃ',
+		"s":  'ㅅ',
+		"ss": 'ㅆ',
+		"c":  'ㅈ',
+		"cc": 'ㅉ',
+		"ch": 'ㅊ',
+		"kh": 'ㅋ',
+		"th": 'ㅌ',
+		"ph": 'ㅍ',
+		"h":  'ㅎ',
+	}
+
+	// 예일 로마자 -> 중성
+	yaleJungseong = map[string]Jamo{
+		"a":   'ㅏ',
+		"ay":  'ㅐ',
+		"ya":  'ㅑ',
+		"yay": 'ㅒ',
+		"e":   'ㅓ',
+		"ey":  'ㅔ',
+		"ye":  'ㅕ',
+		"yey": 'ㅖ',
+		"o":   'ㅗ',
+		"wa":  'ㅘ',
+		"way": 'ㅙ',
+		"oy":  'ㅚ',
+		"yo":  'ㅛ',
+		"wu":  'ㅜ',
+		"we":  'ㅝ',
+		"wey": 'ㅞ',
+		"wi":  'ㅟ',
+		"yu":  'ㅠ',
+		"u":   'ㅡ',
+		"uy":  'ㅢ',
+		"i":   'ㅣ',
+	}
+
+	// 예일 로마자 -> 종성
+	yaleJongseong = map[string]Jamo{
+		"k":   'ㄱ',
+		"kk":  'ㄲ',
+		"ks":  'ㄳ',
+		"n":   'ㄴ',
+		"nc":  'ㄵ',
+		"nh":  'ㄶ',
+		"t":   'ㄷ',
+		"l":   'ㄹ',
+		"lk":  'ㄺ',
+		"lm":  'ㄻ',
+		"lp":  'ㄼ',
+		"ls":  'ㄽ',
+		"lth": 'ㄾ',
+		"lph": 'ㄿ',
+		"lh":  'ㅀ',
+		"m":   'ㅁ',
+		"p":   'ㅂ',
+		"ps":  'ㅄ',
+		"s":   'ㅅ',
+		"ss":  'ㅆ',
+		"ng":  'ㅇ',
+		"c":   'ㅈ',
+		"ch":  'ㅊ',
+		"kh":  'ㅋ',
+		"th":  'ㅌ',
+		"ph":  'ㅍ',
+		"h":   'ㅎ',
+	}
+)
+
+var (
+	// 로마자 -> 초성
+	romajaChoseong = map[string]Jamo{
+		"":   'ㅇ',
+		"g":  'ㄱ',
+		"kk": 'ㄲ',
+		"n":  'ㄴ',
+		"d":  'ㄷ',
+		"tt": 'ㄸ',
+		"r":  'ㄹ',
+		"l":  'ㄹ',
+		"m":  'ㅁ',
+		"b":  'ㅂ',
+		"pp": 'ㅃ',
+		"s":  'ㅅ',
+		"ss": 'ㅆ',
+		"j":  'ㅈ',
+		"jj": 'ㅉ',
+		"ch": 'ㅊ',
+		"k":  'ㅋ',
+		"t":  'ㅌ',
+		"p":  'ㅍ',
+		"h":  'ㅎ',
+	}
+
+	// 로마자 -> 중성
+	romajaJungseong = map[string]Jamo{
+		"a":   'ㅏ',
+		"ae":  'ㅐ',
+		"ya":  'ㅑ',
+		"yae": 'ㅒ',
+		"eo":  'ㅓ',
+		"e":   'ㅔ',
+		"yeo": 'ㅕ',
+		"ye":  'ㅖ',
+		"o":   'ㅗ',
+		"wa":  'ㅘ',
+		"wae": 'ㅙ',
+		"oe":  'ㅚ',
+		"yo":  'ㅛ',
+		"u":   'ㅜ',
+		"wo":  'ㅝ',
+		"we":  'ㅞ',
+		"wi":  'ㅟ',
+		"yu":  'ㅠ',
+		"eu":  'ㅡ',
+		"ui":  'ㅢ',
+		"i":   'ㅣ',
+	}
+
+	// 로마자 -> 종성
+	romajaJongseong = map[string]Jamo{
+		"":   0,
+		"k":  'ㄱ',
+		"ks": 'ㄳ',
+		"n":  'ㄴ',
+		"nj": 'ㄵ',
+		"nh": 'ㄶ',
+		"t":  'ㅅ',
+		"l":  'ㄹ',
+		"lk": 'ㄺ',
+		"lm": 'ㄻ',
+		"lb": 'ㄼ',
+		"ls": 'ㄽ',
+		"lt": 'ㄾ',
+		"lp": 'ㄿ',
+		"lh": 'ㅀ',
+		"m":  'ㅁ',
+		"p":  'ㅂ',
+		"ps": 'ㅄ',
+		"ng": 'ㅇ',
+		"h":  'ㅎ',
+	}
+
+	// 로마자 받침, 초성 -> 비음화, 유음화되기 전의 받침, 초성
+	romajaAssimilationMap = map[[2]string][][2]Jamo{
+		{"m", "n"}:  {{'ㅂ', 'ㄴ'}, {'ㅁ', 'ㄹ'}, {'ㅂ', 'ㄹ'}},
+		{"m", "m"}:  {{'ㅂ', 'ㅁ'}},
+		{"ng", "n"}: {{'ㅇ', 'ㄹ'}, {'ㄱ', 'ㄴ'}, {'ㄱ', 'ㄹ'}},
+		{"ng", "m"}: {{'ㄱ', 'ㅁ'}},
+		{"l", "l"}:  {{'ㄴ', 'ㄹ'}, {'ㄹ', 'ㄴ'}},
+	}
+)
+
+var (
+	// 행정 구역 단위
+	adminUnits = map[string]bool{
+		"도": true,
+		"시": true,
+		"군": true,
+		"구": true,
+		"읍": true,
+		"면": true,
+		"리": true,
+		"동": true,
+		"가": true,
+	}
+
+	// 두 음절 성씨
+	compoundSurnames = map[string]bool{
+		"남궁": true,
+		"독고": true,
+		"동방": true,
+		"사공": true,
+		"서문": true,
+		"선우": true,
+		"제갈": true,
+		"황보": true,
+	}
+)
+
+// RomanizeOptions 로마자 표기 방식
+type RomanizeOptions struct {
+	Literal    bool // 발음의 변화 없이 음절의 자모를 그대로 변환합니다.
+	Name       bool // 인명으로 보고 성과 이름을 띄어 쓰며, 이름에는 발음의 변화를 적용하지 않습니다.
+	Hyphenate  bool // 발음상 혼동의 우려가 있는 음절 사이에 붙임표(-)를 넣습니다. 인명은 이름의 모든 음절 사이에 넣습니다.
+	Capitalize bool // 단어의 첫 글자를 대문자로 적습니다.
+	AdminUnit  bool // 행정 구역 단위(도, 시, 군, 구, 읍, 면, 리, 동, 가) 앞에 붙임표를 넣고, 그 사이의 발음 변화는 적용하지 않습니다.
+}
+
+// RomanizeWith 표기 방식에 따라 로마자로 변환합니다.
+// 단어는 공백으로 나누며, 발음의 변화는 단어 안에서만 적용합니다.
+func RomanizeWith(str string, opts RomanizeOptions) string {
+	if opts.Name {
+		return romanizeName(str, opts)
+	}
+
+	words := strings.Split(str, " ")
+	for i, word := range words {
+		if opts.AdminUnit {
+			if base, unit := splitAdminUnit(word); unit != "" {
+				word = romanize(Disassemble(base), opts) + "-" + romanize(Disassemble(unit), opts)
+			} else {
+				word = romanize(Disassemble(word), opts)
+			}
+		} else {
+			word = romanize(Disassemble(word), opts)
+		}
+
+		if opts.Capitalize {
+			word = capitalize(word)
+		}
+		words[i] = word
+	}
+	return strings.Join(words, " ")
+}
+
+// romanize 단어를 국어의 로마자 표기법에 따라 변환합니다.
+// 붙임표는 적힌 음절 사이에 넣고, 붙임표를 넣은 곳에서는 소리의 변화를 적용하지 않습니다. (선인 -> seon-in)
+func romanize(d Daneo, opts RomanizeOptions) string {
+	if !opts.Literal {
+		pronounced := romajaPronunciation.apply(d)
+		if cuts := hyphenPositions(pronounced, romajaSyllables(pronounced, opts), opts); len(cuts) > 0 {
+			opts.Hyphenate = false
+			parts := make([]string, 0, len(cuts)+1)
+			prev := 0
+			for _, cut := range append(cuts, len(d)) {
+				parts = append(parts, romanize(d[prev:cut], opts))
+				prev = cut
+			}
+			return strings.Join(parts, "-")
+		}
+		d = pronounced
+	}
+
+	syllables := romajaSyllables(d, opts)
+	cuts := hyphenPositions(d, syllables, opts)
+
+	var sb strings.Builder
+	sb.Grow(len(d) * 3)
+	for i, syllable := range syllables {
+		if len(cuts) > 0 && cuts[0] == i {
+			sb.WriteString("-")
+			cuts = cuts[1:]
+		}
+		sb.WriteString(syllable)
+	}
+	return sb.String()
+}
+
+// romajaSyllables 음절마다의 로마자를 반환합니다. 한글이 아닌 글자는 그대로 둡니다.
+func romajaSyllables(d Daneo, opts RomanizeOptions) []string {
+	syllables := make([]string, len(d))
+	for i, e := range d {
+		if !e.isHangul() {
+			syllables[i] = e.Choseong.String()
+			continue
+		}
+
+		var syllable strings.Builder
+		if !e.Choseong.Empty() {
+			// 'ㄹㄹ'은 'll'로 적습니다.
+			if !opts.Literal && e.Choseong.toLetter() == 'ㄹ' && d.At(i-1).Jongseong.toLetter() == 'ㄹ' {
+				syllable.WriteString("l")
+			} else {
+				syllable.WriteString(choseongRomaja[e.Choseong.toLetter().String()])
+			}
+		}
+		if !e.Jungseong.Empty() {
+			syllable.WriteString(jungseongRomaja[e.Jungseong.toLetter().String()])
+		}
+		if !e.Jongseong.Empty() {
+			syllable.WriteString(jongseongRomaja[e.Jongseong.toLetter().String()])
+		}
+		syllables[i] = syllable.String()
+	}
+	return syllables
+}
+
+// hyphenPositions 붙임표를 넣을 음절의 위치를 반환합니다.
+// 인명은 모든 음절 사이에, 그 밖에는 붙여 쓰면 다른 음절로 나뉠 수 있는 음절 사이에 넣습니다.
+func hyphenPositions(d Daneo, syllables []string, opts RomanizeOptions) []int {
+	if !opts.Hyphenate {
+		return nil
+	}
+
+	var cuts []int
+	for i := 1; i < len(d); i++ {
+		if d[i-1].isSyllable() && d[i].isSyllable() &&
+			(opts.Name || isAmbiguousRomaja(syllables[i-1], syllables[i])) {
+			cuts = append(cuts, i)
+		}
+	}
+	return cuts
+}
+
+// romanizeName 인명을 로마자로 변환합니다. 성과 이름은 띄어 씁니다.
+// 공백이 없으면 첫 음절(두 음절 성씨는 두 음절)을 성으로 봅니다.
+func romanizeName(str string, opts RomanizeOptions) string {
+	opts.Literal = true
+
+	var surname, given string
+	if fields := strings.Fields(str); len(fields) > 1 {
+		surname, given = fields[0], strings.Join(fields[1:], "")
+	} else {
+		runes := []rune(strings.TrimSpace(str))
+		n := min(1, len(runes))
+		if len(runes) > 2 && compoundSurnames[string(runes[:2])] {
+			n = 2
+		}
+		surname, given = string(runes[:n]), string(runes[n:])
+	}
+
+	nameOpts := opts
+	nameOpts.Hyphenate = false
+	names := []string{romanize(Disassemble(surname), nameOpts), romanize(Disassemble(given), opts)}
+	if opts.Capitalize {
+		for i := range names {
+			names[i] = capitalize(names[i])
+		}
+	}
+	return strings.TrimSpace(strings.Join(names, " "))
+}
+
+// splitAdminUnit 단어를 지명과 행정 구역 단위로 나눕니다.
+// 단위 앞이 두 음절 이상이거나 숫자일 때만 나누며, 그렇지 않으면 단위는 빈 문자열입니다. (대구, 안동)
+func splitAdminUnit(word string) (string, string) {
+	runes := []rune(word)
+	if len(runes) < 2 || !adminUnits[string(runes[len(runes)-1])] {
+		return word, ""
+	}
+
+	base := string(runes[:len(runes)-1])
+	trimmed := strings.TrimRight(base, "0123456789")
+	if trimmed == base && utf8.RuneCountInString(base) < 2 {
+		return word, ""
+	}
+	// 숫자는 띄어 씁니다. (봉천1동 -> Bongcheon 1-dong)
+	if trimmed != base && trimmed != "" {
+		base = trimmed + " " + base[len(trimmed):]
+	}
+	return base, string(runes[len(runes)-1])
+}
+
+// isAmbiguousRomaja 두 음절의 로마자를 붙여 쓰면 다른 음절로 나뉠 수 있는지 판단합니다.
+func isAmbiguousRomaja(prev, next string) bool {
+	count := func(s string) int {
+		return len(segmentRomaja(s, 0, make(map[int][][]romajaSyllable)))
+	}
+	return count(prev+next) > count(prev)*count(next)
+}
+
+// capitalize 단어의 첫 로마자를 대문자로 바꿉니다.
+// 숫자로 시작하는 단어는 바꾸지 않습니다. (2-ga)
+func capitalize(word string) string {
+	for i, r := range word {
+		switch {
+		case r >= '0' && r <= '9':
+			return word
+		case isLatinLetter(r):
+			return word[:i] + strings.ToUpper(string(r)) + word[i+1:]
+		}
+	}
+	return word
+}
+
+// romajaSyllable 로마자로 적힌 음절
+type romajaSyllable struct {
+	onset  string
+	vowel  string
+	coda   string
+	choice int // 되돌린 소리의 변화 (0: 적힌 그대로)
+}
+
+// deromanizeCandidate 로마자를 한글로 되돌린 후보
+type deromanizeCandidate struct {
+	word     string
+	exact    bool // 다시 로마자로 바꾸면 입력과 같은지
+	syllable int  // 음절 수
+	empty    int  // 초성이 없는 음절 수
+	changed  int  // 되돌린 소리의 변화 수
+	rank     int  // 되돌린 소리의 변화의 순번의 합 (작을수록 흔한 변화)
+}
+
+const (
+	yaleSeparator    = '.' // 예일 음절 구분 기호
+	maxYaleVowelSize = 3   // 가장 긴 예일 모음 로마자의 길이
+
+	maxRomajaSize           = 3  // 가장 긴 로마자 자모의 길이
+	maxRomajaSegments       = 64 // 한 단어에서 찾을 최대 음절 분리 수
+	maxDeromanizeCandidates = 10 // 반환할 최대 후보 수
+)
+
+// RomanizeMR 표준 발음에 따라 매큔-라이샤워 표기법의 로마자로 변환합니다.
+// ascii 가 true 이면 반달표(ŏ, ŭ)를 빼고 ASCII 문자로만 변환합니다.
+func RomanizeMR(str string, ascii ...bool) string {
+	eumjeolList := romajaPronunciation.apply(Disassemble(str))
+	var sb strings.Builder
+	sb.Grow(len(eumjeolList) * 3)
+
+	for i, e := range eumjeolList {
+		if !e.isHangul() {
+			sb.WriteString(e.Choseong.String())
+			continue
+		}
+
+		prev := eumjeolList.At(i - 1)
+		if !e.Choseong.Empty() {
+			cho := e.Choseong.toLetter().String()
+			prevJong := prev.Jongseong.toLetter()
+			voiced, ok := voicedChoseongMcCune[cho]
+
+			switch {
+			case cho == "ㄹ" && prevJong == 'ㄹ':
+				sb.WriteString("l")
+			case ok && endsVoiced(prev):
+				// 'ㄴ' 받침 뒤의 'ㄱ'은 'ㅇ' 받침과 구별하기 위해 어깻점을 찍습니다.
+				if cho == "ㄱ" && prevJong == 'ㄴ' {
+					sb.WriteString("'")
+				}
+				sb.WriteString(voiced)
+			default:
+				sb.WriteString(choseongMcCune[cho])
+			}
+		}
+		if !e.Jungseong.Empty() {
+			sb.WriteString(jungseongMcCune[e.Jungseong.toLetter().String()])
+		}
+		if !e.Jongseong.Empty() {
+			sb.WriteString(jongseongMcCune[e.Jongseong.toLetter().String()])
+		}
+	}
+
+	if len(ascii) > 0 && ascii[0] {
+		return mcCuneASCIIReplacer.Replace(sb.String())
+	}
+	return sb.String()
+}
+
+// endsVoiced 음절이 모음이나 유성 자음(ㄴ, ㄹ, ㅁ, ㅇ)으로 끝나는지 확인합니다.
+func endsVoiced(e Eumjeol) bool {
+	if !e.isSyllable() {
+		return false
+	}
+
+	switch e.Jongseong.toLetter() {
+	case 0, 'ㄴ', 'ㄹ', 'ㅁ', 'ㅇ':
+		return true
+	}
+	return false
+}
+
+// RomanizeYale 발음의 변화 없이 자모를 하나씩 예일 표기법의 로마자로 변환합니다.
+// 음절의 경계가 모호한 곳에는 '.'을 넣어 DeromanizeYale 로 되돌릴 수 있게 합니다.
+func RomanizeYale(str string) string {
+	eumjeolList := Disassemble(str)
+	var sb strings.Builder
+	sb.Grow(len(eumjeolList) * 3)
+
+	for i, e := range eumjeolList {
+		if !e.isHangul() {
+			sb.WriteString(e.Choseong.String())
+			continue
+		}
+
+		if prev := eumjeolList.At(i - 1); prev.isSyllable() && e.isSyllable() && needsYaleSeparator(prev, e) {
+			sb.WriteRune(yaleSeparator)
+		}
+		sb.WriteString(yaleSyllable(e))
+	}
+	return sb.String()
+}
+
+// DeromanizeYale 예일 표기법의 로마자를 한글로 변환합니다.
+// 한글로 변환할 수 없는 로마자는 그대로 둡니다.
+func DeromanizeYale(str string) string {
+	var sb strings.Builder
+	sb.Grow(len(str) * 2)
+
+	runes := []rune(str)
+	start := -1
+
+	for i := 0; i <= len(runes); i++ {
+		if i < len(runes) && (isLatinLetter(runes[i]) ||
+			(runes[i] == yaleSeparator && start >= 0 && i+1 < len(runes) && isLatinLetter(runes[i+1]))) {
+			if start < 0 {
+				start = i
+			}
+			continue
+		}
+
+		if start >= 0 {
+			word := string(runes[start:i])
+			if d, ok := parseYale(strings.ToLower(word)); ok {
+				sb.WriteString(d.Assemble())
+			} else {
+				sb.WriteString(word)
+			}
+			start = -1
+		}
+		if i < len(runes) {
+			sb.WriteRune(runes[i])
+		}
+	}
+	return sb.String()
+}
+
+// yaleSyllable 음절을 예일 로마자로 변환합니다.
+func yaleSyllable(e Eumjeol) string {
+	var sb strings.Builder
+
+	if !e.Choseong.Empty() {
+		cho := e.Choseong.toLetter().String()
+		if v, ok := choseongYale[cho]; ok {
+			sb.WriteString(v)
+		} else {
+			sb.WriteString(jongseongYale[cho])
+		}
+	}
+	if !e.Jungseong.Empty() {
+		sb.WriteString(jungseongYale[e.Jungseong.toLetter().String()])
+	}
+	if !e.Jongseong.Empty() {
+		sb.WriteString(jongseongYale[e.Jongseong.toLetter().String()])
+	}
+	return sb.String()
+}
+
+// needsYaleSeparator 두 음절을 붙여 쓰면 다르게 읽히는지 판단합니다.
+func needsYaleSeparator(prev, next Eumjeol) bool {
+	d, ok := parseYaleChunk(yaleSyllable(prev) + yaleSyllable(next))
+	return !ok || !d.Equals(Daneo{prev, next})
+}
+
+// splitYaleConsonants 모음 사이의 자음 로마자를 앞 음절의 종성과 뒤 음절의 초성으로 나눕니다.
+// 초성이 가장 길어지도록 나눕니다.
+func splitYaleConsonants(consonants string) (string, string, bool) {
+	for i := 0; i < len(consonants); i++ {
+		coda, onset := consonants[:i], consonants[i:]
+		if _, ok := yaleChoseong[onset]; !ok {
+			continue
+		}
+		if _, ok := yaleJongseong[coda]; ok || coda == "" {
+			return coda, onset, true
+		}
+	}
+	return "", "", false
+}
+
+// parseYale 예일 로마자 단어를 음절로 분해합니다.
+func parseYale(word string) (Daneo, bool) {
+	var result Daneo
+
+	for _, chunk := range strings.Split(word, string(yaleSeparator)) {
+		d, ok := parseYaleChunk(chunk)
+		if !ok {
+			return nil, false
+		}
+		result = append(result, d...)
+	}
+	return result, true
+}
+
+// parseYaleChunk 음절 구분 기호가 없는 예일 로마자를 음절로 분해합니다.
+func parseYaleChunk(chunk string) (Daneo, bool) {
+	var result Daneo
+	consonants := ""
+
+	for i := 0; i < len(chunk); {
+		vowel, size := matchYaleVowel(chunk[i:])
+		if size == 0 {
+			consonants += chunk[i : i+1]
+			i++
+			continue
+		}
+
+		onset := Jamo('ㅇ')
+		switch {
+		case consonants == "":
+		case len(result) == 0:
+			v, ok := yaleChoseong[consonants]
+			if !ok {
+				return nil, false
+			}
+			onset = v
+		default:
+			coda, o, ok := splitYaleConsonants(consonants)
+			if !ok {
+				return nil, false
+			}
+			if coda != "" {
+				result[len(result)-1].Jongseong = yaleJongseong[coda].toChoseong()
+			}
+			onset = yaleChoseong[o]
+		}
+
+		result = append(result, Eumjeol{Choseong: onset.toChoseong(), Jungseong: vowel.toChoseong()})
+		consonants = ""
+		i += size
+	}
+
+	if consonants != "" {
+		v, ok := yaleJongseong[consonants]
+		if !ok || len(result) == 0 {
+			return nil, false
+		}
+		result[len(result)-1].Jongseong = v.toChoseong()
+	}
+	return result, len(result) > 0
+}
+
+// matchYaleVowel 문자열의 앞에서 가장 긴 예일 모음 로마자를 찾아 모음과 길이를 반환합니다.
+func matchYaleVowel(str string) (Jamo, int) {
+	for size := min(maxYaleVowelSize, len(str)); size > 0; size-- {
+		if v, ok := yaleJungseong[str[:size]]; ok {
+			return v, size
+		}
+	}
+	return 0, 0
+}
+
+// isLatinLetter 로마자 알파벳인지 확인합니다.
+func isLatinLetter(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z')
+}
+
+// Deromanize 국어의 로마자 표기법으로 적힌 로마자를 한글로 되돌립니다.
+// 음절을 나누는 방법과 소리의 변화를 되돌리는 방법에 따라 여러 후보를 만들어
+// Romanize 로 다시 변환했을 때 입력과 같은 후보 가운데 소리의 변화를 되돌린 후보(jongno: 종로)를 먼저,
+// 그 다음 음절이 적은 후보, 초성이 없는 음절이 적은 후보 순서로 반환합니다.
+// 붙임표 없이 받침 뒤에 초성이 없는 음절이 오도록 나누는 후보(hangang: 항앙)는 만들지 않습니다.
+func Deromanize(str string) []string {
+	type partial struct {
+		text string
+		rank int
+	}
+
+	result := []partial{{}}
+	runes := []rune(str)
+	start := -1
+
+	for i := 0; i <= len(runes); i++ {
+		if i < len(runes) && isLatinLetter(runes[i]) {
+			if start < 0 {
+				start = i
+			}
+			continue
+		}
+
+		var tokens []string
+		if start >= 0 {
+			word := string(runes[start:i])
+			if tokens = deromanizeWord(strings.ToLower(word)); len(tokens) == 0 {
+				tokens = []string{word}
+			}
+			start = -1
+		}
+		if i < len(runes) {
+			if len(tokens) == 0 {
+				tokens = []string{""}
+			}
+			for j := range tokens {
+				tokens[j] += string(runes[i])
+			}
+		}
+		if len(tokens) == 0 {
+			continue
+		}
+
+		next := make([]partial, 0, len(result)*len(tokens))
+		for _, p := range result {
+			for rank, token := range tokens {
+				next = append(next, partial{text: p.text + token, rank: p.rank + rank})
+			}
+		}
+		sort.SliceStable(next, func(a, b int) bool {
+			return next[a].rank < next[b].rank
+		})
+		result = next[:min(len(next), maxDeromanizeCandidates)]
+	}
+
+	words := make([]string, 0, len(result))
+	for _, p := range result {
+		if p.text != "" {
+			words = append(words, p.text)
+		}
+	}
+	return words
+}
+
+// deromanizeWord 로마자 단어를 한글 후보로 되돌려 순위대로 반환합니다.
+func deromanizeWord(word string) []string {
+	var candidates []deromanizeCandidate
+	seen := make(map[string]bool)
+
+	for _, segment := range segmentRomaja(word, 0, make(map[int][][]romajaSyllable)) {
+		if hasLiaisonGap(segment) {
+			continue
+		}
+		for _, syllables := range expandAssimilation(segment) {
+			d := make(Daneo, len(syllables))
+			c := deromanizeCandidate{syllable: len(syllables)}
+
+			for i, s := range syllables {
+				d[i] = Eumjeol{
+					Choseong:  romajaChoseong[s.onset].toChoseong(),
+					Jungseong: romajaJungseong[s.vowel].toChoseong(),
+					Jongseong: romajaJongseong[s.coda].toChoseong(),
+				}
+				if s.onset == "" {
+					c.empty++
+				}
+				if s.choice > 0 {
+					c.changed++
+					c.rank += s.choice
+					d[i-1].Jongseong, d[i].Choseong = assimilatedJamo(syllables[i-1], s)
+				}
+			}
+
+			c.word = d.Assemble()
+			if seen[c.word] {
+				continue
+			}
+			seen[c.word] = true
+			c.exact = Romanize(c.word) == word
+			candidates = append(candidates, c)
+		}
+	}
+
+	sort.SliceStable(candidates, func(a, b int) bool {
+		x, y := candidates[a], candidates[b]
+		switch {
+		case x.exact != y.exact:
+			return x.exact
+		case x.exact && (x.changed > 0) != (y.changed > 0):
+			return x.changed > 0
+		case x.syllable != y.syllable:
+			return x.syllable < y.syllable
+		case x.empty != y.empty:
+			return x.empty < y.empty
+		case x.changed != y.changed:
+			return x.changed < y.changed
+		}
+		return x.rank < y.rank
+	})
+
+	words := make([]string, 0, min(len(candidates), maxDeromanizeCandidates))
+	for i := 0; i < len(candidates) && i < maxDeromanizeCandidates; i++ {
+		words = append(words, candidates[i].word)
+	}
+	return words
+}
+
+// segmentRomaja 로마자 단어를 초성, 중성, 종성으로 나눌 수 있는 모든 방법을 찾습니다.
+func segmentRomaja(word string, pos int, memo map[int][][]romajaSyllable) [][]romajaSyllable {
+	if pos == len(word) {
+		return [][]romajaSyllable{nil}
+	}
+	if v, ok := memo[pos]; ok {
+		return v
+	}
+
+	var result [][]romajaSyllable
+	for onsetSize := 0; onsetSize <= maxRomajaSize && pos+onsetSize <= len(word); onsetSize++ {
+		onset := word[pos : pos+onsetSize]
+		if _, ok := romajaChoseong[onset]; !ok {
+			continue
+		}
+
+		for vowelSize := 1; vowelSize <= maxRomajaSize && pos+onsetSize+vowelSize <= len(word); vowelSize++ {
+			vowelEnd := pos + onsetSize + vowelSize
+			vowel := word[pos+onsetSize : vowelEnd]
+			if _, ok := romajaJungseong[vowel]; !ok {
+				continue
+			}
+
+			for codaSize := 0; codaSize <= maxRomajaSize && vowelEnd+codaSize <= len(word); codaSize++ {
+				coda := word[vowelEnd : vowelEnd+codaSize]
+				if _, ok := romajaJongseong[coda]; !ok {
+					continue
+				}
+
+				for _, rest := range segmentRomaja(word, vowelEnd+codaSize, memo) {
+					if len(result) >= maxRomajaSegments {
+						break
+					}
+					syllables := append([]romajaSyllable{{onset: onset, vowel: vowel, coda: coda}}, rest...)
+					result = append(result, syllables)
+				}
+			}
+		}
+	}
+
+	memo[pos] = result
+	return result
+}
+
+// hasLiaisonGap 받침 뒤에 초성이 없는 음절이 오는지 확인합니다.
+// 로마자 표기는 받침을 다음 음절의 초성으로 옮겨 적으므로, 붙임표 없이 이렇게 나뉘는 경우는 되돌리지 않습니다. (hangang: 항앙)
+func hasLiaisonGap(syllables []romajaSyllable) bool {
+	for i := 1; i < len(syllables); i++ {
+		if syllables[i-1].coda != "" && syllables[i].onset == "" {
+			return true
+		}
+	}
+	return false
+}
+
+// expandAssimilation 음절 사이의 비음화, 유음화를 되돌린 경우를 모두 만듭니다.
+func expandAssimilation(syllables []romajaSyllable) [][]romajaSyllable {
+	result := [][]romajaSyllable{syllables}
+
+	for i := 1; i < len(syllables); i++ {
+		choices := romajaAssimilationMap[[2]string{syllables[i-1].coda, syllables[i].onset}]
+		if len(choices) == 0 {
+			continue
+		}
+
+		n := len(result)
+		for _, r := range result[:n] {
+			for choice := 1; choice <= len(choices) && len(result) < maxRomajaSegments; choice++ {
+				expanded := make([]romajaSyllable, len(r))
+				copy(expanded, r)
+				expanded[i].choice = choice
+				result = append(result, expanded)
+			}
+		}
+	}
+	return result
+}
+
+// assimilatedJamo 소리의 변화를 되돌린 앞 음절의 받침과 뒤 음절의 초성을 반환합니다.
+func assimilatedJamo(prev, next romajaSyllable) (Jamo, Jamo) {
+	v := romajaAssimilationMap[[2]string{prev.coda, next.onset}][next.choice-1]
+	return v[0].toChoseong(), v[1].toChoseong()
+}
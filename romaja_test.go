@@ -0,0 +1,136 @@
+package gohangul
+
+import (
+	"slices"
+	"testing"
+)
+
+func BenchmarkRomanizeMR(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		RomanizeMR("안녕하세요")
+	}
+}
+
+func TestRomanizeMR(t *testing.T) {
+	input := []string{"", "부산", "천안", "한국", "서울", "대구", "종로", "김치", "신라", "독립문", "갈비", "평양", "의정부"}
+	want := []string{"", "pusan", "ch'ŏnan", "han'guk", "sŏul", "taegu", "chongno", "kimch'i", "silla", "tongnimmun", "kalbi", "p'yŏngyang", "ŭijŏngbu"}
+
+	for i, v := range input {
+		output := RomanizeMR(v)
+		if output != want[i] {
+			t.Errorf("RomanizeMR(%q) = %q; want %q", v, output, want[i])
+		}
+	}
+
+	input = []string{"천안", "의정부", "한글"}
+	want = []string{"ch'onan", "uijongbu", "han'gul"}
+
+	for i, v := range input {
+		output := RomanizeMR(v, true)
+		if output != want[i] {
+			t.Errorf("RomanizeMR(%q, true) = %q; want %q", v, output, want[i])
+		}
+	}
+}
+
+func TestRomanizeYale(t *testing.T) {
+	input := []string{"", "한글", "안녕하세요", "닭", "값이", "왜", "의사", "각가", "가까", "아이", "아야", "애아", "ㄲ", "ㅙ", "서울 종로"}
+	want := []string{"", "hankul", "annyenghaseyyo", "talk", "kaps.i", "way", "uysa", "kak.ka", "kakka", "ai", "a.ya", "aya", "kk", "way", "sewul conglo"}
+
+	for i, v := range input {
+		output := RomanizeYale(v)
+		if output != want[i] {
+			t.Errorf("RomanizeYale(%q) = %q; want %q", v, output, want[i])
+		}
+	}
+}
+
+func TestDeromanizeYale(t *testing.T) {
+	input := []string{"", "hankul", "kaps.i", "kak.ka", "kakka", "a.ya", "aya", "sewul conglo.", "Hankul", "xyz"}
+	want := []string{"", "한글", "값이", "각가", "가까", "아야", "애아", "서울 종로.", "한글", "xyz"}
+
+	for i, v := range input {
+		output := DeromanizeYale(v)
+		if output != want[i] {
+			t.Errorf("DeromanizeYale(%q) = %q; want %q", v, output, want[i])
+		}
+	}
+
+	for _, v := range []string{"앉아", "없어", "흙일", "밟히다", "뚫어", "꿇었다", "괜찮아", "쬐었어", "얘기", "띄어쓰기"} {
+		if output := DeromanizeYale(RomanizeYale(v)); output != v {
+			t.Errorf("DeromanizeYale(RomanizeYale(%q)) = %q; want %q", v, output, v)
+		}
+	}
+}
+
+func TestDeromanize(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected []string
+	}{
+		{"", []string{}},
+		{"hangang", []string{"한강"}},
+		{"geo", []string{"거", "게오"}},
+		{"silla", []string{"신라", "실나", "실라"}},
+		{"Busan!", []string{"부산!"}},
+		{"xyz", []string{"xyz"}},
+	}
+
+	for _, test := range tests {
+		result := Deromanize(test.input)
+		if !slices.Equal(result, test.expected) {
+			t.Errorf("Deromanize(%q) = %q; want %q", test.input, result, test.expected)
+		}
+	}
+
+	input := []string{"annyeonghaseyo", "gamsahamnida", "jongno", "gungmin", "oneul", "seoul jongno-gu"}
+	want := []string{"안녕하세요", "감사합니다", "종로", "국민", "오늘", "서울 종로-구"}
+
+	for i, v := range input {
+		if output := Deromanize(v); len(output) == 0 || output[0] != want[i] {
+			t.Errorf("Deromanize(%q) = %q; want %q first", v, output, want[i])
+		}
+	}
+}
+
+func TestRomanizeWith(t *testing.T) {
+	tests := []struct {
+		input    string
+		opts     RomanizeOptions
+		expected string
+	}{
+		{"", RomanizeOptions{}, ""},
+		{"신라", RomanizeOptions{Literal: true}, "sinra"},
+		{"서울 종로", RomanizeOptions{Capitalize: true}, "Seoul Jongno"},
+		{"중앙", RomanizeOptions{Hyphenate: true}, "jung-ang"},
+		{"준강", RomanizeOptions{Hyphenate: true}, "jun-gang"},
+		{"해운대", RomanizeOptions{Hyphenate: true}, "hae-undae"},
+		{"세운", RomanizeOptions{Hyphenate: true}, "se-un"},
+		{"서울", RomanizeOptions{Hyphenate: true}, "seoul"},
+		{"선인", RomanizeOptions{Hyphenate: true}, "seon-in"},
+		{"인왕", RomanizeOptions{Hyphenate: true}, "in-wang"},
+		{"한국어", RomanizeOptions{Hyphenate: true}, "han-gugeo"},
+		{"선인", RomanizeOptions{}, "seonin"},
+		{"홍길동", RomanizeOptions{Name: true, Capitalize: true}, "Hong Gildong"},
+		{"홍길동", RomanizeOptions{Name: true, Capitalize: true, Hyphenate: true}, "Hong Gil-dong"},
+		{"한복남", RomanizeOptions{Name: true, Capitalize: true}, "Han Boknam"},
+		{"홍빛나", RomanizeOptions{Name: true, Capitalize: true, Hyphenate: true}, "Hong Bit-na"},
+		{"남궁민수", RomanizeOptions{Name: true, Capitalize: true}, "Namgung Minsu"},
+		{"충청북도 청주시", RomanizeOptions{AdminUnit: true, Capitalize: true}, "Chungcheongbuk-do Cheongju-si"},
+		{"삼죽면", RomanizeOptions{AdminUnit: true, Capitalize: true}, "Samjuk-myeon"},
+		{"봉천1동", RomanizeOptions{AdminUnit: true, Capitalize: true}, "Bongcheon 1-dong"},
+		{"종로 2가", RomanizeOptions{AdminUnit: true, Capitalize: true}, "Jongno 2-ga"},
+		{"인왕리", RomanizeOptions{AdminUnit: true, Hyphenate: true, Capitalize: true}, "In-wang-ri"},
+		{"종로구", RomanizeOptions{AdminUnit: true, Capitalize: true}, "Jongno-gu"},
+		{"인왕리", RomanizeOptions{AdminUnit: true, Capitalize: true}, "Inwang-ri"},
+		{"대구", RomanizeOptions{AdminUnit: true, Capitalize: true}, "Daegu"},
+		{"안동", RomanizeOptions{AdminUnit: true, Capitalize: true}, "Andong"},
+	}
+
+	for _, test := range tests {
+		result := RomanizeWith(test.input, test.opts)
+		if result != test.expected {
+			t.Errorf("RomanizeWith(%q, %+v) = %q; want %q", test.input, test.opts, result, test.expected)
+		}
+	}
+}
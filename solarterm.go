@@ -0,0 +1,50 @@
+package gohangul
+
+import "time"
+
+// 24절기의 이름 (소한부터 동지까지 양력 한 해의 차례)
+var solarTermNames = [24]string{
+	"소한", "대한", "입춘", "우수", "경칩", "춘분",
+	"청명", "곡우", "입하", "소만", "망종", "하지",
+	"소서", "대서", "입추", "처서", "백로", "추분",
+	"한로", "상강", "입동", "소설", "대설", "동지",
+}
+
+// SolarTerm 24절기
+type SolarTerm struct {
+	Name      string    // 절기의 이름 (입춘)
+	Longitude int       // 태양의 황경 (315)
+	Time      time.Time // 절입 시각 (한국 표준시, 분 단위)
+}
+
+// SolarTerms 양력 연도의 24절기를 소한부터 동지까지 차례대로 반환합니다.
+// 절입 시각은 태양의 겉보기 황경으로 계산하며, 1900년부터 2100년까지 수 분 이내의 오차가 있습니다.
+//
+//	SolarTerms(2026)[2] // 입춘 2026-02-04 05:02 KST
+func SolarTerms(year int) []SolarTerm {
+	terms := make([]SolarTerm, len(solarTermNames))
+	for i, name := range solarTermNames {
+		longitude := (285 + i*15) % 360
+		t := koreaTime(jdeToTime(solarTermJDE(year, float64(longitude))))
+		terms[i] = SolarTerm{Name: name, Longitude: longitude, Time: t.Round(time.Minute)}
+	}
+	return terms
+}
+
+// SolarTermOn 날짜가 절기의 절입일이면 그 절기를 반환합니다. t 의 시간대에서의 날짜를 한국 날짜와 견줍니다.
+//
+//	SolarTermOn(time.Date(2026, 6, 21, 0, 0, 0, 0, time.Local)) // 하지, true
+func SolarTermOn(t time.Time) (SolarTerm, bool) {
+	y, m, d := t.Date()
+	for _, term := range SolarTerms(y) {
+		if ty, tm, td := term.Time.Date(); ty == y && tm == m && td == d {
+			return term, true
+		}
+	}
+	return SolarTerm{}, false
+}
+
+// String 절기를 이름과 절입 시각으로 씁니다. (입춘 2026년 2월 4일 오전 5시 2분)
+func (s SolarTerm) String() string {
+	return s.Name + " " + FormatTime(s.Time, "{Y}년 {M}월 {D}일 {A} {h}시 {m}분")
+}
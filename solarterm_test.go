@@ -0,0 +1,79 @@
+package gohangul
+
+import (
+	"testing"
+	"time"
+)
+
+func BenchmarkSolarTerms(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		SolarTerms(2026)
+	}
+}
+
+func TestSolarTerms(t *testing.T) {
+	tests := []struct {
+		year     int
+		name     string
+		expected string
+	}{
+		{2024, "입춘", "2024-02-04 17:27"},
+		{2024, "춘분", "2024-03-20 12:06"},
+		{2024, "하지", "2024-06-21 05:51"},
+		{2025, "하지", "2025-06-21 11:42"},
+		{2025, "동지", "2025-12-22 00:03"},
+		{2026, "소한", "2026-01-05 17:23"},
+		{2026, "입춘", "2026-02-04 05:02"},
+		{2026, "추분", "2026-09-23 09:05"},
+	}
+
+	for _, test := range tests {
+		terms := SolarTerms(test.year)
+		if len(terms) != 24 {
+			t.Fatalf("len(SolarTerms(%d)) = %d; want 24", test.year, len(terms))
+		}
+		for _, term := range terms {
+			if term.Name != test.name {
+				continue
+			}
+			if result := term.Time.Format("2006-01-02 15:04"); result != test.expected {
+				// 근사 계산이므로 2분까지의 차이는 허용합니다.
+				expected, _ := time.ParseInLocation("2006-01-02 15:04", test.expected, term.Time.Location())
+				if diff := term.Time.Sub(expected); diff < -2*time.Minute || diff > 2*time.Minute {
+					t.Errorf("SolarTerms(%d) %s = %s; want %s", test.year, test.name, result, test.expected)
+				}
+			}
+		}
+	}
+
+	terms := SolarTerms(2026)
+	for i := 1; i < len(terms); i++ {
+		if !terms[i-1].Time.Before(terms[i].Time) {
+			t.Errorf("SolarTerms(2026)[%d] = %v; not after %v", i, terms[i], terms[i-1])
+		}
+	}
+}
+
+func TestSolarTermOn(t *testing.T) {
+	tests := []struct {
+		date     time.Time
+		expected string
+	}{
+		{time.Date(2026, 2, 4, 0, 0, 0, 0, time.UTC), "입춘"},
+		{time.Date(2026, 6, 21, 0, 0, 0, 0, time.UTC), "하지"},
+		{time.Date(2025, 12, 22, 0, 0, 0, 0, time.UTC), "동지"},
+		{time.Date(2026, 6, 22, 0, 0, 0, 0, time.UTC), ""},
+	}
+
+	for _, test := range tests {
+		term, ok := SolarTermOn(test.date)
+		if term.Name != test.expected || ok != (test.expected != "") {
+			t.Errorf("SolarTermOn(%v) = %q, %t; want %q", test.date, term.Name, ok, test.expected)
+		}
+	}
+
+	term, _ := SolarTermOn(time.Date(2026, 2, 4, 0, 0, 0, 0, time.UTC))
+	if result := term.String(); result != "입춘 2026년 2월 4일 오전 5시 2분" {
+		t.Errorf("String() = %q; want %q", result, "입춘 2026년 2월 4일 오전 5시 2분")
+	}
+}